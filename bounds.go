@@ -0,0 +1,91 @@
+package datepicker
+
+import "time"
+
+// SetBounds sets the model's `MinDate` and `MaxDate`. Passing the zero
+// `time.Time` removes the respective bound. The current date is moved within
+// the new bounds.
+func (m *Model) SetBounds(min, max time.Time) {
+	m.MinDate = min
+	m.MaxDate = max
+	m.setCursor(m.clamp(m.cursor()))
+}
+
+// InBounds reports whether the date of t falls on or between `MinDate` and `MaxDate`
+func (m Model) InBounds(t time.Time) bool {
+	if !m.MinDate.IsZero() && compareDays(t, m.MinDate) < 0 {
+		return false
+	}
+	if !m.MaxDate.IsZero() && compareDays(t, m.MaxDate) > 0 {
+		return false
+	}
+	return true
+}
+
+// clamp moves t onto `MinDate` or `MaxDate` when it falls outside of the bounds,
+// keeping the time of day of t
+func (m Model) clamp(t time.Time) time.Time {
+	if !m.MinDate.IsZero() && compareDays(t, m.MinDate) < 0 {
+		return withDate(t, m.MinDate)
+	}
+	if !m.MaxDate.IsZero() && compareDays(t, m.MaxDate) > 0 {
+		return withDate(t, m.MaxDate)
+	}
+	return t
+}
+
+// canGoLastMonth reports whether any date of the month before the first
+// visible month is within bounds
+func (m Model) canGoLastMonth() bool {
+	if m.MinDate.IsZero() {
+		return true
+	}
+	return compareMonths(m.MinDate, m.firstVisibleMonth()) < 0
+}
+
+// canGoNextMonth reports whether any date of the month after the last visible
+// month is within bounds
+func (m Model) canGoNextMonth() bool {
+	if m.MaxDate.IsZero() {
+		return true
+	}
+	months := m.visibleMonths()
+	return compareMonths(m.MaxDate, months[len(months)-1]) > 0
+}
+
+// monthInBounds reports whether any date of the month beginning on first is
+// within bounds
+func (m Model) monthInBounds(first time.Time) bool {
+	if !m.MinDate.IsZero() && compareMonths(first, m.MinDate) < 0 {
+		return false
+	}
+	if !m.MaxDate.IsZero() && compareMonths(first, m.MaxDate) > 0 {
+		return false
+	}
+	return true
+}
+
+// yearInBounds reports whether any date of year is within bounds
+func (m Model) yearInBounds(year int) bool {
+	if !m.MinDate.IsZero() && year < m.MinDate.Year() {
+		return false
+	}
+	if !m.MaxDate.IsZero() && year > m.MaxDate.Year() {
+		return false
+	}
+	return true
+}
+
+// withDate returns the calendar date of d with the time of day and location of t
+func withDate(t, d time.Time) time.Time {
+	y, mo, day := d.Date()
+	return onDate(y, mo, day, t)
+}
+
+// compareMonths compares the year and month of a and b
+func compareMonths(a, b time.Time) int {
+	if a.Year() != b.Year() {
+		return sign(a.Year() - b.Year())
+	}
+	return sign(int(a.Month() - b.Month()))
+}
@@ -0,0 +1,109 @@
+package datepicker
+
+import (
+	"testing"
+	"time"
+)
+
+var (
+	octFirst = time.Date(2023, time.October, 1, 0, 0, 0, 0, time.UTC)
+	decFirst = time.Date(2023, time.December, 1, 0, 0, 0, 0, time.UTC)
+)
+
+func TestInBounds(t *testing.T) {
+	tests := []struct {
+		min, max time.Time
+		input    time.Time
+		want     bool
+	}{
+		{input: halloween, want: true},
+		{min: octFirst, input: octFirst, want: true},
+		{min: octFirst, input: octFirst.Add(-time.Nanosecond), want: false},
+		{max: decFirst, input: decFirst.Add(23 * time.Hour), want: true},
+		{max: decFirst, input: xmas, want: false},
+		{min: octFirst, max: decFirst, input: thanksgiving, want: true},
+	}
+	for i, test := range tests {
+		model := New(halloween)
+		model.SetBounds(test.min, test.max)
+		if got := model.InBounds(test.input); test.want != got {
+			t.Errorf("TestInBounds failure - index: %d - want: '%t' got: '%t'", i, test.want, got)
+		}
+	}
+}
+
+func TestNavigationBounds(t *testing.T) {
+	tests := []struct {
+		name     string
+		navigate func(*Model)
+		input    time.Time
+		want     time.Time
+	}{
+		{name: "LastWeek", navigate: (*Model).LastWeek, input: time.Date(2023, time.October, 3, 0, 0, 0, 0, time.UTC), want: octFirst},
+		{name: "NextWeek", navigate: (*Model).NextWeek, input: time.Date(2023, time.November, 28, 0, 0, 0, 0, time.UTC), want: decFirst},
+		{name: "Yesterday", navigate: (*Model).Yesterday, input: octFirst, want: octFirst},
+		{name: "Tomorrow", navigate: (*Model).Tomorrow, input: decFirst, want: decFirst},
+		{name: "LastMonth", navigate: (*Model).LastMonth, input: halloween, want: octFirst},
+		{name: "NextMonth", navigate: (*Model).NextMonth, input: thanksgiving, want: decFirst},
+		{name: "LastYear", navigate: (*Model).LastYear, input: thanksgiving, want: octFirst},
+		{name: "NextYear", navigate: (*Model).NextYear, input: thanksgiving, want: decFirst},
+		{name: "NextWeek", navigate: (*Model).NextWeek, input: halloween, want: time.Date(2023, time.November, 7, 0, 0, 0, 0, time.UTC)},
+	}
+	for i, test := range tests {
+		model := New(test.input)
+		model.SetBounds(octFirst, decFirst)
+		test.navigate(&model)
+		if got := model.Time; test.want != got {
+			t.Errorf("TestNavigationBounds failure - index: %d - %s - want: '%s' got: '%s'", i, test.name, test.want, got)
+		}
+	}
+}
+
+func TestNavigationBoundsKeepsTimeOfDay(t *testing.T) {
+	input := time.Date(2023, time.October, 2, 15, 30, 0, 0, time.UTC)
+	want := time.Date(2023, time.October, 1, 15, 30, 0, 0, time.UTC)
+
+	model := New(input)
+	model.SetBounds(octFirst, decFirst)
+	model.LastWeek()
+	if got := model.Time; want != got {
+		t.Errorf("TestNavigationBoundsKeepsTimeOfDay failure - want: '%s' got: '%s'", want, got)
+	}
+}
+
+func TestSelectDateOutOfBounds(t *testing.T) {
+	model := New(xmas)
+	model.MaxDate = decFirst
+
+	model.SelectDate()
+	if model.Selected {
+		t.Errorf("TestSelectDateOutOfBounds failure - expected `SelectDate` to refuse a date after `MaxDate`")
+	}
+
+	model.SelectionMode = SelectionMultiple
+	model.ToggleDate()
+	if model.IsDateSelected(xmas) {
+		t.Errorf("TestSelectDateOutOfBounds failure - expected `ToggleDate` to refuse a date after `MaxDate`")
+	}
+}
+
+func TestHeaderArrowBounds(t *testing.T) {
+	tests := []struct {
+		input              time.Time
+		wantLast, wantNext bool
+	}{
+		{input: halloween, wantLast: false, wantNext: true},
+		{input: thanksgiving, wantLast: true, wantNext: true},
+		{input: xmas, wantLast: true, wantNext: false},
+	}
+	for i, test := range tests {
+		model := New(test.input)
+		model.MinDate, model.MaxDate = octFirst, decFirst
+		if got := model.canGoLastMonth(); test.wantLast != got {
+			t.Errorf("TestHeaderArrowBounds failure - index: %d - last month - want: '%t' got: '%t'", i, test.wantLast, got)
+		}
+		if got := model.canGoNextMonth(); test.wantNext != got {
+			t.Errorf("TestHeaderArrowBounds failure - index: %d - next month - want: '%t' got: '%t'", i, test.wantNext, got)
+		}
+	}
+}
@@ -4,11 +4,11 @@ package datepicker
 
 import (
 	"fmt"
-	"strconv"
-	"strings"
 	"time"
 
+	"github.com/charmbracelet/bubbles/help"
 	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/textinput"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
 )
@@ -30,6 +30,64 @@ const (
 
 //go:generate stringer -type=Focus
 
+// SelectionMode is a value assigned to `Model.SelectionMode` to indicate how
+// selecting a date behaves.
+type SelectionMode int
+
+const (
+	// SelectionSingle selects the single date under the cursor
+	SelectionSingle SelectionMode = iota
+	// SelectionRange anchors a start date on the first selection and completes the range with the second
+	SelectionRange
+	// SelectionMultiple toggles any number of non-contiguous dates in and out of a selection set
+	SelectionMultiple
+)
+
+// WeekNumbering is a value assigned to `Model.WeekNumbering` to indicate whether
+// and how week numbers are shown in a leading column of the calendar.
+type WeekNumbering int
+
+const (
+	// WeekNumberingNone hides the week number column
+	WeekNumberingNone WeekNumbering = iota
+	// WeekNumberingISO shows ISO 8601 week numbers, where weeks start on Monday and
+	// week 1 contains the year's first Thursday
+	WeekNumberingISO
+	// WeekNumberingUS shows US week numbers, where weeks start on Sunday and
+	// week 1 contains January 1st
+	WeekNumberingUS
+)
+
+// ViewMode is a value assigned to `Model.ViewMode` to indicate how much of the
+// calendar is shown.
+type ViewMode int
+
+const (
+	// ViewMonth shows the dates of the month, or months, around `Model.Time`
+	ViewMonth ViewMode = iota
+	// ViewYear shows all twelve months of the year of `Model.Time` as compact calendars
+	ViewYear
+	// ViewMonthPicker shows the months of the year of `Model.Time` to pick from
+	ViewMonthPicker
+	// ViewYearPicker shows the years of the decade of `Model.Time` to pick from
+	ViewYearPicker
+)
+
+// MonthOverflow is a value assigned to `Model.MonthOverflow` to indicate where
+// moving by months or years lands when the day of the month does not exist in
+// the new month, such as moving from January 31 to February.
+type MonthOverflow int
+
+const (
+	// MonthOverflowClamp lands on the last day of the new month and remembers
+	// the day moved from, so that January 31 moves to February 28 and then on
+	// to March 31
+	MonthOverflowClamp MonthOverflow = iota
+	// MonthOverflowNormalize carries the missing days into the following month
+	// the way `time.Time.AddDate` does, so that January 31 moves to March 3
+	MonthOverflowNormalize
+)
+
 // KeyMap is the key bindings for different actions within the datepicker.
 type KeyMap struct {
 	Up        key.Binding
@@ -38,19 +96,37 @@ type KeyMap struct {
 	Left      key.Binding
 	FocusPrev key.Binding
 	FocusNext key.Binding
+	Select    key.Binding
+	Toggle    key.Binding
+	Cancel    key.Binding
 	Quit      key.Binding
+	Today     key.Binding
+	YearView  key.Binding
+	LastPage  key.Binding
+	NextPage  key.Binding
+	Prompt    key.Binding
+	Help      key.Binding
 }
 
 // DefaultKeyMap returns a KeyMap struct with default values
 func DefaultKeyMap() KeyMap {
 	return KeyMap{
-		Up:        key.NewBinding(key.WithKeys("up", "k")),
-		Right:     key.NewBinding(key.WithKeys("right", "l")),
-		Down:      key.NewBinding(key.WithKeys("down", "j")),
-		Left:      key.NewBinding(key.WithKeys("left", "h")),
-		FocusPrev: key.NewBinding(key.WithKeys("shift+tab")),
-		FocusNext: key.NewBinding(key.WithKeys("tab")),
-		Quit:      key.NewBinding(key.WithKeys("ctrl+c", "q")),
+		Up:        key.NewBinding(key.WithKeys("up", "k"), key.WithHelp("↑/k", "up")),
+		Right:     key.NewBinding(key.WithKeys("right", "l"), key.WithHelp("→/l", "right")),
+		Down:      key.NewBinding(key.WithKeys("down", "j"), key.WithHelp("↓/j", "down")),
+		Left:      key.NewBinding(key.WithKeys("left", "h"), key.WithHelp("←/h", "left")),
+		FocusPrev: key.NewBinding(key.WithKeys("shift+tab"), key.WithHelp("shift+tab", "focus previous")),
+		FocusNext: key.NewBinding(key.WithKeys("tab"), key.WithHelp("tab", "focus next")),
+		Select:    key.NewBinding(key.WithKeys("enter"), key.WithHelp("enter", "select")),
+		Toggle:    key.NewBinding(key.WithKeys(" "), key.WithHelp("space", "toggle")),
+		Cancel:    key.NewBinding(key.WithKeys("esc"), key.WithHelp("esc", "clear")),
+		Quit:      key.NewBinding(key.WithKeys("ctrl+c", "q"), key.WithHelp("q", "close")),
+		Today:     key.NewBinding(key.WithKeys("t"), key.WithHelp("t", "today")),
+		YearView:  key.NewBinding(key.WithKeys("y"), key.WithHelp("y", "year view")),
+		LastPage:  key.NewBinding(key.WithKeys("pgup"), key.WithHelp("pgup", "last page")),
+		NextPage:  key.NewBinding(key.WithKeys("pgdown"), key.WithHelp("pgdown", "next page")),
+		Prompt:    key.NewBinding(key.WithKeys("/"), key.WithHelp("/", "go to date")),
+		Help:      key.NewBinding(key.WithKeys("?"), key.WithHelp("?", "more")),
 	}
 }
 
@@ -63,6 +139,22 @@ type Styles struct {
 	Text         lipgloss.Style
 	SelectedText lipgloss.Style
 	FocusedText  lipgloss.Style
+
+	RangeText         lipgloss.Style
+	RangeEndpointText lipgloss.Style
+	MultiSelectedText lipgloss.Style
+	OutOfBoundsText   lipgloss.Style
+	DisabledText      lipgloss.Style
+	WeekNumberText    lipgloss.Style
+	TodayText         lipgloss.Style
+	OutsideMonthText  lipgloss.Style
+
+	YearMonth  lipgloss.Style
+	YearDate   lipgloss.Style
+	PickerCell lipgloss.Style
+
+	Prompt      lipgloss.Style
+	PromptError lipgloss.Style
 }
 
 // DefaultStyles returns a default `Styles` struct
@@ -76,6 +168,22 @@ func DefaultStyles() Styles {
 		Text:         r.NewStyle().Foreground(lipgloss.Color("247")),
 		SelectedText: r.NewStyle().Bold(true),
 		FocusedText:  r.NewStyle().Foreground(lipgloss.Color("212")).Bold(true),
+
+		RangeText:         r.NewStyle().Foreground(lipgloss.Color("147")),
+		RangeEndpointText: r.NewStyle().Foreground(lipgloss.Color("147")).Bold(true).Underline(true),
+		MultiSelectedText: r.NewStyle().Foreground(lipgloss.Color("114")).Bold(true),
+		OutOfBoundsText:   r.NewStyle().Foreground(lipgloss.Color("238")),
+		DisabledText:      r.NewStyle().Foreground(lipgloss.Color("240")).Strikethrough(true),
+		WeekNumberText:    r.NewStyle().Foreground(lipgloss.Color("241")).Italic(true),
+		TodayText:         r.NewStyle().Foreground(lipgloss.Color("39")).Underline(true),
+		OutsideMonthText:  r.NewStyle().Foreground(lipgloss.Color("239")),
+
+		YearMonth:  r.NewStyle().Padding(1, 1, 0),
+		YearDate:   r.NewStyle().PaddingLeft(1),
+		PickerCell: r.NewStyle().Padding(0, 1, 1),
+
+		Prompt:      r.NewStyle().PaddingTop(1),
+		PromptError: r.NewStyle().Foreground(lipgloss.Color("203")),
 	}
 }
 
@@ -94,16 +202,155 @@ type Model struct {
 	// Focused indicates the component which the end user is focused on
 	Focused Focus
 
-	// Selected indicates whether a date is Selected in the datepicker
+	// Selected indicates whether a date is Selected in the datepicker. In
+	// `SelectionRange` mode it indicates whether the range is complete and in
+	// `SelectionMultiple` mode whether any date is in the selection set.
 	Selected bool
+
+	// SelectionMode indicates whether selecting picks a single date, a range of
+	// dates or a set of dates
+	SelectionMode SelectionMode
+
+	// RangeStart is the first date of the selected range. It is the zero
+	// `time.Time` when no range has been anchored.
+	RangeStart time.Time
+
+	// RangeEnd is the last date of the selected range. It is the zero
+	// `time.Time` until the range is complete.
+	RangeEnd time.Time
+
+	// MinDate is the earliest date the end user can navigate to. The zero
+	// `time.Time` means there is no lower bound.
+	MinDate time.Time
+
+	// MaxDate is the latest date the end user can navigate to. The zero
+	// `time.Time` means there is no upper bound.
+	MaxDate time.Time
+
+	// Locale supplies the month and weekday names used to render the datepicker
+	Locale Locale
+
+	// WeekStart is the first day of the week shown in the leftmost column of
+	// the calendar. The zero value starts weeks on Sunday.
+	WeekStart time.Weekday
+
+	// Location is the time zone the dates of the calendar are shown and
+	// navigated in. A nil Location uses the location of `Time`. `Time` keeps its
+	// own location either way.
+	Location *time.Location
+
+	// MonthOverflow indicates where moving by months or years lands when the day
+	// of the month does not exist in the new month. The zero value clamps to the
+	// last day of the month.
+	MonthOverflow MonthOverflow
+
+	// ViewMode indicates whether the month view, the year overview or one of the
+	// month and year pickers is shown
+	ViewMode ViewMode
+
+	// YearViewColumns is the number of months per row in the year overview. The
+	// zero value lays the twelve months out in 3 columns.
+	YearViewColumns int
+
+	// Months is the number of consecutive months shown side by side. Values
+	// below 2 show the month of `Time` only.
+	Months int
+
+	// MonthsPerRow wraps the months shown onto multiple rows. The zero value
+	// lays every month out in a single row.
+	MonthsPerRow int
+
+	// ShowOutsideDays renders the leading and trailing days of the adjacent
+	// months instead of leaving their cells blank. Clicking one of them moves
+	// the calendar to its month.
+	ShowOutsideDays bool
+
+	// WeekNumbering indicates whether a leading column of week numbers is shown
+	WeekNumbering WeekNumbering
+
+	// Now returns the current time and is used to highlight and jump to today's
+	// date. A nil Now uses `time.Now`.
+	Now func() time.Time
+
+	// Help renders the help line below the calendar when `ShowHelp` is set
+	Help help.Model
+
+	// ShowHelp renders a help line for the bindings available to the current focus
+	ShowHelp bool
+
+	// Prompt is the text input shown below the calendar by `OpenPrompt` for
+	// typing date expressions
+	Prompt textinput.Model
+
+	// QuitOnClose makes `KeyMap.Quit` quit the bubbletea program instead
+	// of sending a `CloseMsg`. It is meant for programs where the datepicker is
+	// the only component.
+	QuitOnClose bool
+
+	// Disabled reports whether a date cannot be selected, such as weekends or
+	// holidays. A nil Disabled leaves every date enabled.
+	Disabled DisabledFunc
+
+	// TypeAheadTimeout is how long typing a day number into the calendar waits
+	// for another digit before the next digit starts a new number. The zero
+	// value waits 1 second.
+	TypeAheadTimeout time.Duration
+
+	// firstMonth is the first of the visible months. It only moves when the
+	// cursor leaves the visible months or the months are scrolled.
+	firstMonth time.Time
+
+	// preferredDay is the day of the month the cursor last moved by months or
+	// years from, and preferredAt is the date it landed on. Moving by months
+	// again from preferredAt aims for preferredDay rather than the clamped day.
+	// Any other move of the cursor resets preferredDay.
+	preferredDay int
+	preferredAt  Date
+
+	// offsetX and offsetY are where the datepicker is drawn on the screen
+	offsetX, offsetY int
+
+	// selectedDates is the set of dates toggled in `SelectionMultiple` mode
+	selectedDates map[Date]time.Time
+
+	// selecting reports whether the date was selected since `Update` started,
+	// so that moving the selected date in `SelectionSingle` mode is not
+	// announced as a selection
+	selecting bool
+
+	// pickerOrigin is the date the month or year picker was opened at
+	pickerOrigin time.Time
+
+	// typed is the text typed into the focused header or calendar, and
+	// typedOrigin is the date the typing started at
+	typed       string
+	typedOrigin time.Time
+
+	// typedMove is set when typed is a letter of the month header that moved
+	// the cursor as a key binding. It only counts as typed when the next letter
+	// continues a month name.
+	typedMove bool
+
+	// prompting is set while the prompt is shown, and promptErr describes why
+	// the last date submitted to it was refused
+	prompting bool
+	promptErr string
+
+	// typedID identifies the latest digit typed into the calendar, so that the
+	// timeouts of earlier digits are ignored
+	typedID int
 }
 
 // New returns the Model of the datepicker
-func New(time time.Time) Model {
+func New(t time.Time) Model {
 	return Model{
-		Time:   time,
+		Time:   t,
 		KeyMap: DefaultKeyMap(),
 		Styles: DefaultStyles(),
+		Locale: LocaleEnglish,
+		Help:   help.New(),
+		Prompt: newPrompt(),
+		Now:    time.Now,
 
 		Focused:  FocusCalendar,
 		Selected: false,
@@ -117,11 +364,82 @@ func (m Model) Init() tea.Cmd {
 
 // Update changes the state of the datepicker. Update satisfies the `tea.Model` interface
 func (m Model) Update(msg tea.Msg) (Model, tea.Cmd) {
+	m.firstMonth = m.firstVisibleMonth()
+	m.selecting = false
+	prev := m
+	// typingCmd is returned along with the msgs describing the changes to the
+	// model, for the timeouts and cursor blinks of text being typed
+	var typingCmd tea.Cmd
+
 	switch msg := msg.(type) {
+	case typeAheadTimeoutMsg:
+		if msg.id == m.typedID && m.Focused == FocusCalendar {
+			m.typed = ""
+		}
+
+	case tea.MouseMsg:
+		m.typed = ""
+		m.updateMouse(msg)
+
+	case ZoneSelectedMsg:
+		m.SetZone(msg.New)
+
 	case tea.KeyMsg:
+		if m.prompting {
+			if used, cmd := m.updatePrompt(msg); used {
+				typingCmd = cmd
+				break
+			}
+		}
+
+		if used, cmd := m.updateTyping(msg); used {
+			typingCmd = cmd
+			break
+		}
+
 		switch {
 		case key.Matches(msg, m.KeyMap.Quit):
-			return m, tea.Quit
+			if m.QuitOnClose {
+				return m, tea.Quit
+			}
+			return m, func() tea.Msg { return CloseMsg{} }
+
+		case key.Matches(msg, m.KeyMap.Help):
+			m.Help.ShowAll = !m.Help.ShowAll
+
+		case key.Matches(msg, m.KeyMap.Today):
+			if m.Focused != FocusNone {
+				m.Today()
+			}
+
+		case key.Matches(msg, m.KeyMap.LastPage):
+			if m.Focused != FocusNone {
+				m.page(-1)
+			}
+
+		case key.Matches(msg, m.KeyMap.NextPage):
+			if m.Focused != FocusNone {
+				m.page(1)
+			}
+
+		case m.ViewMode == ViewYear:
+			m.updateYearView(msg)
+
+		case m.ViewMode == ViewMonthPicker:
+			m.updateMonthPicker(msg)
+
+		case m.ViewMode == ViewYearPicker:
+			m.updateYearPicker(msg)
+
+		case key.Matches(msg, m.KeyMap.YearView):
+			if m.Focused != FocusNone {
+				m.SetViewMode(ViewYear)
+			}
+
+		case key.Matches(msg, m.KeyMap.Prompt):
+			if m.Focused != FocusNone {
+				typingCmd = m.OpenPrompt()
+			}
 
 		case key.Matches(msg, m.KeyMap.Up):
 			m.updateUp()
@@ -150,9 +468,38 @@ func (m Model) Update(msg tea.Msg) (Model, tea.Cmd) {
 			case FocusHeaderYear:
 				m.SetFocus(FocusCalendar)
 			}
+
+		case key.Matches(msg, m.KeyMap.Select):
+			switch m.Focused {
+			case FocusHeaderMonth:
+				m.openPicker(ViewMonthPicker)
+			case FocusHeaderYear:
+				m.openPicker(ViewYearPicker)
+			case FocusCalendar:
+				m.SelectDate()
+			}
+
+		case key.Matches(msg, m.KeyMap.Toggle):
+			if m.Focused != FocusCalendar {
+				break
+			}
+			m.ToggleDate()
+
+		case key.Matches(msg, m.KeyMap.Cancel):
+			if m.Focused == FocusNone {
+				break
+			}
+			m.UnselectDate()
+		}
+
+	default:
+		// the prompt's cursor blinks while it is shown
+		if m.prompting {
+			m.Prompt, typingCmd = m.Prompt.Update(msg)
 		}
 	}
-	return m, nil
+	m.firstMonth = m.firstVisibleMonth()
+	return m, batch(m.changeCmd(prev), typingCmd)
 }
 
 func (m *Model) updateUp() {
@@ -209,90 +556,210 @@ func (m *Model) updateLeft() {
 // View renders a month view as a multiline string in the bubbletea application.
 // View satisfies the `tea.Model` interface.
 func (m Model) View() string {
+	return m.render().view
+}
 
-	b := strings.Builder{}
-	month := m.Time.Month()
-	year := m.Time.Year()
+// render lays out the month view along with the clickable regions used to map
+// mouse events onto it
+func (m Model) render() block {
+	var body block
+	switch m.ViewMode {
+	case ViewYear:
+		body = m.renderYear()
+	case ViewMonthPicker:
+		body = m.renderMonthPicker()
+	case ViewYearPicker:
+		body = m.renderYearPicker()
+	default:
+		body = m.renderMonths()
+	}
 
-	tMonth, tYear := month.String(), strconv.Itoa(year)
+	rows := []block{body}
+	if m.prompting {
+		rows = append(rows, m.renderPrompt())
+	}
+	if m.ShowHelp {
+		rows = append(rows, plain(m.Help.View(m)))
+	}
+	return joinVertical(lipgloss.Center, rows...)
+}
 
-	if m.Focused == FocusHeaderMonth {
-		tMonth = m.Styles.FocusedText.Render(tMonth)
-	} else {
-		tMonth = m.Styles.HeaderText.Render(tMonth)
+// renderMonths lays out the visible months side by side
+func (m Model) renderMonths() block {
+	months := m.visibleMonths()
+	panes := make([]block, len(months))
+	for i, first := range months {
+		panes[i] = m.renderMonth(first, i == 0, i == len(months)-1)
 	}
 
-	if m.Focused == FocusHeaderYear {
-		tYear = m.Styles.FocusedText.Render(tYear)
-	} else {
-		tYear = m.Styles.HeaderText.Render(tYear)
+	perRow := m.MonthsPerRow
+	if perRow <= 0 {
+		perRow = len(panes)
+	}
+	paneRows := []block{}
+	for i := 0; i < len(panes); i += perRow {
+		row := []block{}
+		for j := i; j < i+perRow && j < len(panes); j++ {
+			if j > i {
+				row = append(row, plain("  "))
+			}
+			row = append(row, panes[j])
+		}
+		paneRows = append(paneRows, joinHorizontal(lipgloss.Top, row...))
 	}
 
-	title := m.Styles.Header.Render(fmt.Sprintf("%s %s\n", tMonth, tYear))
+	return joinVertical(lipgloss.Left, paneRows...)
+}
+
+// renderMonth renders the title and calendar of the month beginning on first.
+// The arrows to the previous and next month are only rendered when showPrev and
+// showNext are set, so that multiple months share a single pair of arrows.
+func (m Model) renderMonth(first time.Time, showPrev, showNext bool) block {
+	month := first.Month()
+	year := first.Year()
 
-	// get all the dates of the current month
-	firstDayOfTheMonth := time.Date(year, month, 1, 0, 0, 0, 0, time.UTC)
+	locale := m.locale()
+	title := m.renderHeader(first, showPrev, showNext)
 
-	lastSundayOfLastMonth := firstDayOfTheMonth.AddDate(0, 0, -1)
-	for lastSundayOfLastMonth.Weekday() != time.Sunday {
-		lastSundayOfLastMonth = lastSundayOfLastMonth.AddDate(0, 0, -1)
+	weekHeaders := []block{}
+	if m.WeekNumbering != WeekNumberingNone {
+		weekHeaders = append(weekHeaders, plain(m.Styles.Date.Render("  ")))
+	}
+	for _, wd := range weekdays(m.WeekStart) {
+		h := locale.WeekdayName(wd)
+		weekHeaders = append(weekHeaders, plain(m.Styles.Date.Copy().Inherit(m.Styles.HeaderText).Render(h)))
 	}
 
-	lastDayOfTheMonth := firstDayOfTheMonth.AddDate(0, 1, -1)
+	cal := [][]block{weekHeaders}
+	for _, week := range monthGrid(year, month, m.WeekStart, m.location()) {
+		row := []block{}
+		if m.WeekNumbering != WeekNumberingNone {
+			wk := fmt.Sprintf("%02d", weekNumber(week, m.WeekNumbering))
+			row = append(row, plain(m.Styles.Date.Copy().Inherit(m.Styles.WeekNumberText).Render(wk)))
+		}
+		for _, day := range week {
+			style := m.Styles.Date
+			if day.Month() != month && !m.ShowOutsideDays {
+				row = append(row, plain(style.Copy().Inherit(m.Styles.Text.Copy()).Render("  ")))
+				continue
+			}
+			out := fmt.Sprintf("%02d", day.Day())
+			textStyle := m.dateTextStyle(day)
+			if day.Month() != month {
+				textStyle = m.outsideTextStyle(day)
+			}
+			row = append(row, clickable(style.Copy().Inherit(textStyle.Copy()).Render(out), target{kind: targetDate, date: day}))
+		}
+		cal = append(cal, row)
+	}
 
-	firstSundayOfNextMonth := lastDayOfTheMonth.AddDate(0, 0, 1)
-	for firstSundayOfNextMonth.Weekday() != time.Sunday {
-		firstSundayOfNextMonth = firstSundayOfNextMonth.AddDate(0, 0, 1)
+	rows := []block{title}
+	for _, row := range cal {
+		rows = append(rows, joinHorizontal(lipgloss.Center, row...))
 	}
+	return joinVertical(lipgloss.Center, rows...)
+}
+
+// renderHeader renders the month and year title of the month beginning on
+// first, flanked by the arrows to the previous and next month
+func (m Model) renderHeader(first time.Time, showPrev, showNext bool) block {
+	locale := m.locale()
+	tMonth, tYear := locale.MonthName(first.Month()), locale.FormatYear(first.Year())
+	isCursorMonth := compareMonths(first, m.cursor()) == 0
 
-	day := lastSundayOfLastMonth
-	if firstDayOfTheMonth.Weekday() == time.Sunday {
-		day = firstDayOfTheMonth
+	if m.Focused == FocusHeaderMonth && isCursorMonth {
+		tMonth = m.Styles.FocusedText.Render(tMonth)
+	} else {
+		tMonth = m.Styles.HeaderText.Render(tMonth)
+	}
+
+	if m.Focused == FocusHeaderYear && isCursorMonth && m.typed != "" {
+		tYear = m.typedYearText()
+	} else if m.Focused == FocusHeaderYear && isCursorMonth {
+		tYear = m.Styles.FocusedText.Render(tYear)
+	} else {
+		tYear = m.Styles.HeaderText.Render(tYear)
 	}
 
-	weekHeaders := []string{"Su", "Mo", "Tu", "We", "Th", "Fr", "Sa"}
-	for i, h := range weekHeaders {
-		weekHeaders[i] = m.Styles.Date.Copy().Inherit(m.Styles.HeaderText).Render(h)
+	prevArrow := clickable(m.Styles.HeaderText.Render("<"), target{kind: targetLastMonth})
+	if !m.canGoLastMonth() {
+		prevArrow = clickable(m.Styles.OutOfBoundsText.Render("<"), target{kind: targetLastMonth})
+	}
+	if !showPrev {
+		prevArrow = plain(" ")
 	}
 
-	cal := [][]string{weekHeaders}
-	j := 1
+	nextArrow := clickable(m.Styles.HeaderText.Render(">"), target{kind: targetNextMonth})
+	if !m.canGoNextMonth() {
+		nextArrow = clickable(m.Styles.OutOfBoundsText.Render(">"), target{kind: targetNextMonth})
+	}
+	if !showNext {
+		nextArrow = plain(" ")
+	}
 
-	for day.Before(firstSundayOfNextMonth) {
-		if j >= len(cal) {
-			cal = append(cal, []string{})
-		}
-		out := "  "
-		if day.Month() == month {
-			out = fmt.Sprintf("%02d", day.Day())
-		}
+	tFirst := clickable(tMonth, target{kind: targetMonth, date: first})
+	tSecond := clickable(tYear, target{kind: targetYear, date: first})
+	if locale.YearFirst {
+		tFirst, tSecond = tSecond, tFirst
+	}
 
-		style := m.Styles.Date
-		textStyle := m.Styles.Text
-		if !m.Selected {
-			// skip modifications to the date
-		} else if day.Day() == m.Time.Day() && day.Month() == m.Time.Month() && m.Focused == FocusCalendar {
-			textStyle = m.Styles.FocusedText
-		} else if day.Day() == m.Time.Day() && day.Month() == m.Time.Month() {
-			textStyle = m.Styles.SelectedText
-		}
+	title := joinHorizontal(lipgloss.Top,
+		prevArrow, plain(" "), tFirst, plain(" "), tSecond, plain(" "), nextArrow,
+	)
+	title.view += "\n"
 
-		out = style.Copy().Inherit(textStyle.Copy()).Render(out)
-		cal[j] = append(cal[j], out)
+	return styled(m.Styles.Header, title)
+}
 
-		if day.Weekday() == time.Saturday {
-			j++
-		}
-		day = day.AddDate(0, 0, 1)
+// dateTextStyle returns the text style for a date within the month being viewed.
+// Today's date combines `TodayText` with the style of its selection state.
+func (m Model) dateTextStyle(day time.Time) lipgloss.Style {
+	style, ok := m.stateTextStyle(day)
+	isToday := sameDay(day, m.now())
+	switch {
+	case !ok && isToday:
+		return m.Styles.TodayText.Copy().Inherit(m.Styles.Text)
+	case !ok:
+		return m.Styles.Text
+	case isToday:
+		return style.Copy().Inherit(m.Styles.TodayText)
 	}
+	return style
+}
 
-	rows := []string{title}
-	for _, row := range cal {
-		rows = append(rows, lipgloss.JoinHorizontal(lipgloss.Center, row...))
+// outsideTextStyle returns the text style for a date shown from a neighbouring
+// month. `OutsideMonthText` fills in whatever the style of the date's state or
+// `TodayText` leaves unset.
+func (m Model) outsideTextStyle(day time.Time) lipgloss.Style {
+	if _, ok := m.stateTextStyle(day); ok || sameDay(day, m.now()) {
+		return m.dateTextStyle(day).Copy().Inherit(m.Styles.OutsideMonthText)
 	}
-	b.WriteString(lipgloss.JoinVertical(lipgloss.Center, rows...))
+	return m.Styles.OutsideMonthText
+}
 
-	return b.String()
+// stateTextStyle returns the text style for the selection state of a date, and
+// false when the date has no particular state
+func (m Model) stateTextStyle(day time.Time) (lipgloss.Style, bool) {
+	isCursor := sameDay(day, m.cursor())
+	cursorVisible := m.Selected || m.SelectionMode != SelectionSingle || m.ViewMode == ViewYear
+
+	switch {
+	case isCursor && cursorVisible && m.Focused == FocusCalendar:
+		return m.Styles.FocusedText, true
+	case !m.InBounds(day):
+		return m.Styles.OutOfBoundsText, true
+	case m.IsDisabled(day):
+		return m.Styles.DisabledText, true
+	case m.SelectionMode == SelectionMultiple && m.IsDateSelected(day):
+		return m.Styles.MultiSelectedText, true
+	case m.SelectionMode == SelectionRange && m.isRangeEndpoint(day):
+		return m.Styles.RangeEndpointText, true
+	case m.SelectionMode == SelectionRange && m.InRange(day):
+		return m.Styles.RangeText, true
+	case isCursor && m.Selected && m.SelectionMode == SelectionSingle:
+		return m.Styles.SelectedText, true
+	}
+	return lipgloss.Style{}, false
 }
 
 // SetsFocus focuses one of the datepicker components. This can also be used to blur
@@ -306,57 +773,130 @@ func (m *Model) Blur() {
 	m.Focused = FocusNone
 }
 
+// SetLocale sets the model's `Locale` along with the `WeekStart` the locale prefers
+func (m *Model) SetLocale(l Locale) {
+	m.Locale = l
+	m.WeekStart = l.WeekStart
+}
+
+// locale returns the model's `Locale`, falling back to English when it is unset
+func (m Model) locale() Locale {
+	if m.Locale.Months[0] == "" {
+		return LocaleEnglish
+	}
+	return m.Locale
+}
+
 // SetTime sets the model's `Time` struct and is used as reference to the selected date
 func (m *Model) SetTime(t time.Time) {
 	m.Time = t
+	m.preferredDay = 0
+}
+
+// Date returns the date of the model's `Time` in the display location. It is
+// the date the cursor is on, and the selected date once `Selected` is set.
+func (m Model) Date() Date {
+	return DateOf(m.cursor())
+}
+
+// SetDate moves the model's `Time` to d in the display location, keeping the
+// time of day
+func (m *Model) SetDate(d Date) {
+	m.setCursor(d.At(m.cursor()))
 }
 
-// LastWeek sets the model's `Time` struct back 7 days
+// Today sets the model's `Time` struct to the current date, keeping the time of
+// day and stopping at `MinDate` or `MaxDate`
+func (m *Model) Today() {
+	m.setCursor(m.clamp(withDate(m.cursor(), m.now())))
+}
+
+// now returns the current time from the model's `Now` clock in the display location
+func (m Model) now() time.Time {
+	if m.Now == nil {
+		return time.Now().In(m.location())
+	}
+	return m.Now().In(m.location())
+}
+
+// LastWeek sets the model's `Time` struct back 7 days, skipping over disabled dates
+// and stopping at `MinDate`
 func (m *Model) LastWeek() {
-	m.Time = m.Time.AddDate(0, 0, -7)
+	m.step(-7)
 }
 
-// NextWeek sets the model's `Time` struct forward 7 days
+// NextWeek sets the model's `Time` struct forward 7 days, skipping over disabled dates
+// and stopping at `MaxDate`
 func (m *Model) NextWeek() {
-	m.Time = m.Time.AddDate(0, 0, 7)
+	m.step(7)
 }
 
-// Yesterday sets the model's `Time` struct back 1 day
+// Yesterday sets the model's `Time` struct back 1 day, skipping over disabled dates
+// and stopping at `MinDate`
 func (m *Model) Yesterday() {
-	m.Time = m.Time.AddDate(0, 0, -1)
+	m.step(-1)
 }
 
-// Tomorrow sets the model's `Time` struct forward 1 day
+// Tomorrow sets the model's `Time` struct forward 1 day, skipping over disabled dates
+// and stopping at `MaxDate`
 func (m *Model) Tomorrow() {
-	m.Time = m.Time.AddDate(0, 0, 1)
+	m.step(1)
 }
 
-// LastMonth sets the model's `Time` struct back 1 month
+// LastMonth sets the model's `Time` struct back 1 month following
+// `MonthOverflow`, stopping at `MinDate`
 func (m *Model) LastMonth() {
-	m.Time = m.Time.AddDate(0, -1, 0)
+	m.addMonths(-1)
 }
 
-// NextMonth sets the model's `Time` struct forward 1 month
+// NextMonth sets the model's `Time` struct forward 1 month following
+// `MonthOverflow`, stopping at `MaxDate`
 func (m *Model) NextMonth() {
-	m.Time = m.Time.AddDate(0, 1, 0)
+	m.addMonths(1)
 }
 
-// LastYear sets the model's `Time` struct back 1 year
+// LastYear sets the model's `Time` struct back 1 year following
+// `MonthOverflow`, stopping at `MinDate`
 func (m *Model) LastYear() {
-	m.Time = m.Time.AddDate(-1, 0, 0)
+	m.addMonths(-12)
 }
 
-// NextYear sets the model's `Time` struct forward 1 year
+// NextYear sets the model's `Time` struct forward 1 year following
+// `MonthOverflow`, stopping at `MaxDate`
 func (m *Model) NextYear() {
-	m.Time = m.Time.AddDate(1, 0, 0)
+	m.addMonths(12)
 }
 
-// SelectDate changes the model's Selected to true
+// SelectDate changes the model's Selected to true. In `SelectionRange` mode the
+// first call anchors `RangeStart` to the current date and the second call
+// completes the range. In `SelectionMultiple` mode the current date is added to
+// the selection set. Disabled dates and dates outside of `MinDate` and `MaxDate`
+// are refused.
 func (m *Model) SelectDate() {
-	m.Selected = true
+	if !m.IsSelectable(m.cursor()) {
+		return
+	}
+	switch m.SelectionMode {
+	case SelectionRange:
+		m.selectRangeDate()
+	case SelectionMultiple:
+		m.setDateSelected(m.cursor(), true)
+	default:
+		m.Selected = true
+		m.selecting = true
+	}
 }
 
-// UnselectDate changes the model's Selected to false
+// UnselectDate changes the model's Selected to false. In `SelectionRange` mode
+// the range is cleared as well and in `SelectionMultiple` mode the current date
+// is removed from the selection set.
 func (m *Model) UnselectDate() {
-	m.Selected = false
+	switch m.SelectionMode {
+	case SelectionRange:
+		m.ClearRange()
+	case SelectionMultiple:
+		m.setDateSelected(m.cursor(), false)
+	default:
+		m.Selected = false
+	}
 }
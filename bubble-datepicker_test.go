@@ -152,7 +152,7 @@ func TestLastMonth(t *testing.T) {
 		input time.Time
 		want  time.Time
 	}{
-		{input: halloween, want: time.Date(2023, time.September, 31, 0, 0, 0, 0, time.UTC)}, // normalizes
+		{input: halloween, want: time.Date(2023, time.September, 30, 0, 0, 0, 0, time.UTC)}, // clamps
 		{input: thanksgiving, want: time.Date(2023, time.October, 23, 0, 0, 0, 0, time.UTC)},
 		{input: xmas, want: time.Date(2023, time.November, 25, 0, 0, 0, 0, time.UTC)},
 	}
@@ -170,7 +170,7 @@ func TestNextMonth(t *testing.T) {
 		input time.Time
 		want  time.Time
 	}{
-		{input: halloween, want: time.Date(2023, time.November, 31, 0, 0, 0, 0, time.UTC)}, // normalizes
+		{input: halloween, want: time.Date(2023, time.November, 30, 0, 0, 0, 0, time.UTC)}, // clamps
 		{input: thanksgiving, want: time.Date(2023, time.December, 23, 0, 0, 0, 0, time.UTC)},
 		{input: xmas, want: time.Date(2024, time.January, 25, 0, 0, 0, 0, time.UTC)},
 	}
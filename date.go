@@ -0,0 +1,152 @@
+package datepicker
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// dateLayout is the layout of the string form of a `Date`
+const dateLayout = "2006-01-02"
+
+// Date is a calendar date without a time of day or location. It is the value
+// the datepicker picks, while `Model.Time` carries the time of day and location
+// the date is shown in. The zero Date is January 0 of year 0 and is reported by
+// `IsZero`.
+type Date struct {
+	Year  int
+	Month time.Month
+	Day   int
+}
+
+// NewDate returns the Date for year, month and day. Values outside of their
+// usual ranges are normalized the way `time.Date` does, so October 32
+// becomes November 1.
+func NewDate(year int, month time.Month, day int) Date {
+	return DateOf(time.Date(year, month, day, 0, 0, 0, 0, time.UTC))
+}
+
+// DateOf returns the date of t in the location of t
+func DateOf(t time.Time) Date {
+	y, mo, d := t.Date()
+	return Date{Year: y, Month: mo, Day: d}
+}
+
+// ParseDate parses a date in the "2006-01-02" form returned by `Date.String`
+func ParseDate(s string) (Date, error) {
+	return ParseDateLayout(dateLayout, s)
+}
+
+// ParseDateLayout parses a date with a `time.Parse` layout. Any time of day or
+// zone in the layout is ignored.
+func ParseDateLayout(layout, s string) (Date, error) {
+	t, err := time.Parse(layout, s)
+	if err != nil {
+		return Date{}, err
+	}
+	return DateOf(t), nil
+}
+
+// In returns the first moment of d in loc. That is midnight, unless a daylight
+// saving change skips midnight on d.
+func (d Date) In(loc *time.Location) time.Time {
+	return startOfDay(d.Year, d.Month, d.Day, loc)
+}
+
+// At returns the time of day of t on d, in the location of t. Where that time
+// of day is skipped on d by a daylight saving change, the result is moved
+// forward so that it still falls on d.
+func (d Date) At(t time.Time) time.Time {
+	return onDate(d.Year, d.Month, d.Day, t)
+}
+
+// IsZero reports whether d is the zero Date
+func (d Date) IsZero() bool {
+	return d == Date{}
+}
+
+// IsValid reports whether d is a real calendar date, such that February 30
+// is not
+func (d Date) IsValid() bool {
+	return NewDate(d.Year, d.Month, d.Day) == d
+}
+
+// Weekday returns the day of the week of d
+func (d Date) Weekday() time.Weekday {
+	return d.utc().Weekday()
+}
+
+// AddDays returns d moved by n days
+func (d Date) AddDays(n int) Date {
+	return NewDate(d.Year, d.Month, d.Day+n)
+}
+
+// AddDate returns d moved by years, months and days, normalizing the result
+// the way `time.Time.AddDate` does
+func (d Date) AddDate(years int, months time.Month, days int) Date {
+	return NewDate(d.Year+years, d.Month+months, d.Day+days)
+}
+
+// DaysSince returns the number of days from u to d
+func (d Date) DaysSince(u Date) int {
+	return int((d.utc().Unix() - u.utc().Unix()) / (24 * 60 * 60))
+}
+
+// Compare compares d and u. The result is -1 if d is before u, 0 if they are
+// the same date and +1 otherwise.
+func (d Date) Compare(u Date) int {
+	switch {
+	case d.Year != u.Year:
+		return sign(d.Year - u.Year)
+	case d.Month != u.Month:
+		return sign(int(d.Month - u.Month))
+	default:
+		return sign(d.Day - u.Day)
+	}
+}
+
+// Before reports whether d is before u
+func (d Date) Before(u Date) bool {
+	return d.Compare(u) < 0
+}
+
+// After reports whether d is after u
+func (d Date) After(u Date) bool {
+	return d.Compare(u) > 0
+}
+
+// Format formats d with a `time.Time.Format` layout. Any time of day in the
+// layout is formatted as midnight UTC.
+func (d Date) Format(layout string) string {
+	return d.utc().Format(layout)
+}
+
+// String returns d in the "2006-01-02" form
+func (d Date) String() string {
+	return fmt.Sprintf("%04d-%02d-%02d", d.Year, d.Month, d.Day)
+}
+
+// MarshalText satisfies the `encoding.TextMarshaler` interface. Like
+// `time.Time`, it refuses years that `ParseDate` cannot read back, those
+// outside of 0 to 9999.
+func (d Date) MarshalText() ([]byte, error) {
+	if d.Year < 0 || d.Year > 9999 {
+		return nil, errors.New("Date.MarshalText: year outside of range [0,9999]")
+	}
+	return []byte(d.String()), nil
+}
+
+// UnmarshalText satisfies the `encoding.TextUnmarshaler` interface
+func (d *Date) UnmarshalText(data []byte) error {
+	parsed, err := ParseDate(string(data))
+	if err != nil {
+		return err
+	}
+	*d = parsed
+	return nil
+}
+
+// utc returns midnight UTC on d
+func (d Date) utc() time.Time {
+	return time.Date(d.Year, d.Month, d.Day, 0, 0, 0, 0, time.UTC)
+}
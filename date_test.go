@@ -0,0 +1,257 @@
+package datepicker
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func TestNewDate(t *testing.T) {
+	tests := []struct {
+		year  int
+		month time.Month
+		day   int
+		want  Date
+	}{
+		{year: 2023, month: time.October, day: 31, want: Date{2023, time.October, 31}},
+		{year: 2023, month: time.October, day: 32, want: Date{2023, time.November, 1}},
+		{year: 2024, month: time.February, day: 30, want: Date{2024, time.March, 1}},
+		{year: 2023, month: time.January, day: 0, want: Date{2022, time.December, 31}},
+		{year: 2023, month: 13, day: 1, want: Date{2024, time.January, 1}},
+	}
+	for i, test := range tests {
+		if got := NewDate(test.year, test.month, test.day); test.want != got {
+			t.Errorf("TestNewDate failure - index: %d - want: '%s' got: '%s'", i, test.want, got)
+		}
+	}
+}
+
+func TestDateOf(t *testing.T) {
+	tokyo := loadLocation(t, "Asia/Tokyo")
+
+	// 20:00 UTC on October 31 is November 1 in Tokyo
+	utc := time.Date(2023, time.October, 31, 20, 0, 0, 0, time.UTC)
+	if got, want := DateOf(utc), (Date{2023, time.October, 31}); got != want {
+		t.Errorf("TestDateOf failure - want: '%s' got: '%s'", want, got)
+	}
+	if got, want := DateOf(utc.In(tokyo)), (Date{2023, time.November, 1}); got != want {
+		t.Errorf("TestDateOf failure - want: '%s' got: '%s'", want, got)
+	}
+}
+
+func TestDateArithmetic(t *testing.T) {
+	d := Date{2023, time.December, 31}
+
+	if got, want := d.AddDays(1), (Date{2024, time.January, 1}); got != want {
+		t.Errorf("TestDateArithmetic failure - AddDays - want: '%s' got: '%s'", want, got)
+	}
+	if got, want := d.AddDays(-365), (Date{2022, time.December, 31}); got != want {
+		t.Errorf("TestDateArithmetic failure - AddDays - want: '%s' got: '%s'", want, got)
+	}
+	if got, want := d.AddDate(0, 2, 0), (Date{2024, time.March, 2}); got != want {
+		t.Errorf("TestDateArithmetic failure - AddDate - want: '%s' got: '%s'", want, got)
+	}
+	if got, want := d.DaysSince(Date{2023, time.January, 1}), 364; got != want {
+		t.Errorf("TestDateArithmetic failure - DaysSince - want: '%d' got: '%d'", want, got)
+	}
+	if got, want := (Date{2024, time.March, 31}).DaysSince(Date{2024, time.April, 1}), -1; got != want {
+		t.Errorf("TestDateArithmetic failure - DaysSince - want: '%d' got: '%d'", want, got)
+	}
+	if got, want := (Date{2400, time.January, 1}).DaysSince(Date{2000, time.January, 1}), 146097; got != want {
+		t.Errorf("TestDateArithmetic failure - DaysSince - want: '%d' got: '%d'", want, got)
+	}
+	if got := d.Weekday(); got != time.Sunday {
+		t.Errorf("TestDateArithmetic failure - Weekday - want: '%s' got: '%s'", time.Sunday, got)
+	}
+}
+
+func TestDateCompare(t *testing.T) {
+	tests := []struct {
+		a, b Date
+		want int
+	}{
+		{a: Date{2023, time.October, 31}, b: Date{2023, time.October, 31}, want: 0},
+		{a: Date{2023, time.October, 31}, b: Date{2023, time.November, 1}, want: -1},
+		{a: Date{2024, time.January, 1}, b: Date{2023, time.December, 31}, want: 1},
+		{a: Date{2023, time.October, 2}, b: Date{2023, time.October, 1}, want: 1},
+	}
+	for i, test := range tests {
+		if got := test.a.Compare(test.b); test.want != got {
+			t.Errorf("TestDateCompare failure - index: %d - want: '%d' got: '%d'", i, test.want, got)
+		}
+		if got := test.a.Before(test.b); got != (test.want < 0) {
+			t.Errorf("TestDateCompare failure - index: %d - Before - got: '%t'", i, got)
+		}
+		if got := test.a.After(test.b); got != (test.want > 0) {
+			t.Errorf("TestDateCompare failure - index: %d - After - got: '%t'", i, got)
+		}
+	}
+}
+
+func TestDateValid(t *testing.T) {
+	tests := []struct {
+		input Date
+		want  bool
+	}{
+		{input: Date{2024, time.February, 29}, want: true},
+		{input: Date{2023, time.February, 29}, want: false},
+		{input: Date{2023, time.April, 31}, want: false},
+		{input: Date{2023, 13, 1}, want: false},
+		{input: Date{}, want: false},
+	}
+	for i, test := range tests {
+		if got := test.input.IsValid(); test.want != got {
+			t.Errorf("TestDateValid failure - index: %d - want: '%t' got: '%t'", i, test.want, got)
+		}
+	}
+	if !(Date{}).IsZero() {
+		t.Errorf("TestDateValid failure - expected the zero Date to be zero")
+	}
+}
+
+func TestParseDate(t *testing.T) {
+	d, err := ParseDate("2023-12-25")
+	if err != nil || d != (Date{2023, time.December, 25}) {
+		t.Errorf("TestParseDate failure - want: '%s' got: '%s' err: %v", "2023-12-25", d, err)
+	}
+	if _, err := ParseDate("2023-02-30"); err == nil {
+		t.Errorf("TestParseDate failure - expected an error for an invalid date")
+	}
+
+	d, err = ParseDateLayout("01/02/2006 15:04", "11/23/2023 23:30")
+	if err != nil || d != (Date{2023, time.November, 23}) {
+		t.Errorf("TestParseDate failure - want: '%s' got: '%s' err: %v", "2023-11-23", d, err)
+	}
+}
+
+func TestDateFormat(t *testing.T) {
+	d := Date{2023, time.November, 5}
+	if got, want := d.String(), "2023-11-05"; got != want {
+		t.Errorf("TestDateFormat failure - want: '%s' got: '%s'", want, got)
+	}
+	if got, want := d.Format("Mon Jan 2, 2006"), "Sun Nov 5, 2023"; got != want {
+		t.Errorf("TestDateFormat failure - want: '%s' got: '%s'", want, got)
+	}
+
+	data, err := json.Marshal(map[string]Date{"date": d})
+	if err != nil || string(data) != `{"date":"2023-11-05"}` {
+		t.Errorf("TestDateFormat failure - want: '%s' got: '%s' err: %v", `{"date":"2023-11-05"}`, data, err)
+	}
+	var parsed struct{ Date Date }
+	if err := json.Unmarshal([]byte(`{"Date":"2023-11-05"}`), &parsed); err != nil || parsed.Date != d {
+		t.Errorf("TestDateFormat failure - want: '%s' got: '%s' err: %v", d, parsed.Date, err)
+	}
+}
+
+func TestDateMarshalText(t *testing.T) {
+	tests := []struct {
+		date Date
+		ok   bool
+	}{
+		{date: Date{2023, time.November, 5}, ok: true},
+		{date: Date{0, time.January, 1}, ok: true},
+		{date: Date{9999, time.December, 31}, ok: true},
+		{date: Date{-5, time.January, 1}, ok: false},
+		{date: Date{10000, time.January, 1}, ok: false},
+	}
+	for i, test := range tests {
+		data, err := test.date.MarshalText()
+		if (err == nil) != test.ok {
+			t.Errorf("TestDateMarshalText failure - index: %d - want ok: %t got: '%s' err: %v", i, test.ok, data, err)
+			continue
+		}
+		if !test.ok {
+			continue
+		}
+		var parsed Date
+		if err := parsed.UnmarshalText(data); err != nil || parsed != test.date {
+			t.Errorf("TestDateMarshalText failure - index: %d - want: '%s' got: '%s' err: %v", i, test.date, parsed, err)
+		}
+	}
+}
+
+func TestDateConversions(t *testing.T) {
+	ny := loadLocation(t, "America/New_York")
+	saoPaulo := loadLocation(t, "America/Sao_Paulo")
+
+	d := Date{2023, time.November, 5}
+	if got, want := d.In(ny), time.Date(2023, time.November, 5, 0, 0, 0, 0, ny); got != want {
+		t.Errorf("TestDateConversions failure - want: '%s' got: '%s'", want, got)
+	}
+	// midnight was skipped on November 4 2018 in São Paulo
+	if got := (Date{2018, time.November, 4}).In(saoPaulo); DateOf(got) != (Date{2018, time.November, 4}) || got.Hour() != 1 {
+		t.Errorf("TestDateConversions failure - want: '%s' got: '%s'", "2018-11-04 01:00", got)
+	}
+
+	clock := time.Date(2020, time.January, 1, 15, 4, 5, 6, ny)
+	if got, want := d.At(clock), time.Date(2023, time.November, 5, 15, 4, 5, 6, ny); got != want {
+		t.Errorf("TestDateConversions failure - want: '%s' got: '%s'", want, got)
+	}
+	// 00:30 was skipped on November 4 2018 in São Paulo
+	clock = time.Date(2018, time.November, 3, 0, 30, 0, 0, saoPaulo)
+	if got := (Date{2018, time.November, 4}).At(clock); DateOf(got) != (Date{2018, time.November, 4}) || got.Hour() != 1 || got.Minute() != 30 {
+		t.Errorf("TestDateConversions failure - want: '%s' got: '%s'", "2018-11-04 01:30", got)
+	}
+}
+
+func TestModelDate(t *testing.T) {
+	tokyo := loadLocation(t, "Asia/Tokyo")
+
+	// 20:00 UTC on October 31 is shown as November 1 in Tokyo
+	model := New(time.Date(2023, time.October, 31, 20, 0, 0, 0, time.UTC))
+	model.SetLocation(tokyo)
+	if got, want := model.Date(), (Date{2023, time.November, 1}); got != want {
+		t.Errorf("TestModelDate failure - want: '%s' got: '%s'", want, got)
+	}
+
+	model.SetDate(Date{2023, time.December, 25})
+	if want := time.Date(2023, time.December, 24, 20, 0, 0, 0, time.UTC); model.Time != want {
+		t.Errorf("TestModelDate failure - want: '%s' got: '%s'", want, model.Time)
+	}
+	if got, want := model.Date(), (Date{2023, time.December, 25}); got != want {
+		t.Errorf("TestModelDate failure - want: '%s' got: '%s'", want, got)
+	}
+}
+
+func TestModelDateSelection(t *testing.T) {
+	tokyo := loadLocation(t, "Asia/Tokyo")
+	halloween, xmas := Date{2023, time.October, 31}, Date{2023, time.December, 25}
+
+	// 20:00 UTC is shown on the next day in Tokyo
+	model := New(time.Date(2023, time.October, 31, 20, 0, 0, 0, time.UTC))
+	model.SetLocation(tokyo)
+
+	model.SetDates(xmas, halloween)
+	if got, want := model.Dates(), []Date{halloween, xmas}; !reflect.DeepEqual(got, want) {
+		t.Errorf("TestModelDateSelection failure - want: '%v' got: '%v'", want, got)
+	}
+	if !model.IsDateSelected(halloween.In(tokyo)) {
+		t.Errorf("TestModelDateSelection failure - expected '%s' to be selected", halloween)
+	}
+
+	model.SelectionMode = SelectionRange
+	if start, end := model.DateRange(); !start.IsZero() || !end.IsZero() {
+		t.Errorf("TestModelDateSelection failure - want an empty range got: '%s' '%s'", start, end)
+	}
+	model.SetDateRange(xmas, halloween)
+	if start, end := model.DateRange(); start != halloween || end != xmas {
+		t.Errorf("TestModelDateSelection failure - want: '%s' '%s' got: '%s' '%s'", halloween, xmas, start, end)
+	}
+
+	model.ClearRange()
+	model.SetDate(halloween)
+	model.SelectDate()
+	prev := model
+	model.SetDate(xmas)
+	model.SelectDate()
+	want := []tea.Msg{
+		CursorMovedMsg{Old: prev.Time, New: model.Time, OldDate: halloween, NewDate: xmas},
+		RangeSelectedMsg{Start: prev.Time.In(tokyo), End: model.Time.In(tokyo), StartDate: halloween, EndDate: xmas},
+	}
+	if got := model.changeMsgs(prev); !reflect.DeepEqual(want, got) {
+		t.Errorf("TestModelDateSelection failure - want: '%v' got: '%v'", want, got)
+	}
+}
@@ -0,0 +1,291 @@
+// Package dateparse resolves typed date expressions such as "tomorrow",
+// "next friday", "+3w", "end of month" or "2024-01-31" against a reference time.
+package dateparse
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ErrUnrecognized is returned, wrapped, when an expression cannot be parsed
+var ErrUnrecognized = errors.New("dateparse: unrecognized date")
+
+// Parse resolves the expression s against ref. Expressions are case
+// insensitive and may be one of:
+//
+//   - "today", "now", "tomorrow" or "yesterday"
+//   - a weekday such as "friday" or "fri", which is ref's date on that weekday
+//     or the next occurrence after it, and "next friday" or "last friday" for the
+//     first occurrence strictly after or before ref
+//   - "next week", "last month", "next year" and so on
+//   - an offset such as "+3w", "-2d", "+1m", "in 3 days", "3 weeks" or "2 years ago"
+//   - "start of month", "end of month", "start of year" or "end of year", optionally
+//     preceded by "next" or "last" as in "end of next month"
+//   - an ISO 8601 date such as "2024-01-31", "20240131", "2024-01", "2024-W05-3",
+//     "2024-W05", "2024-031" or an RFC 3339 timestamp
+//
+// Except for RFC 3339 timestamps, the result keeps the time of day and
+// location of ref. Adding months or years keeps the day within the length of
+// the resulting month, so "+1m" from January 31 is the last day of February.
+func Parse(s string, ref time.Time) (time.Time, error) {
+	expr := strings.Join(strings.Fields(strings.ToLower(s)), " ")
+	if expr == "" {
+		return time.Time{}, fmt.Errorf("%w: empty expression", ErrUnrecognized)
+	}
+
+	for _, parse := range parsers {
+		if t, ok := parse(expr, ref); ok {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("%w: %q", ErrUnrecognized, s)
+}
+
+// parsers are tried in order until one of them recognizes the expression
+var parsers = []func(expr string, ref time.Time) (time.Time, bool){
+	parseKeyword,
+	parseWeekday,
+	parseRelativeUnit,
+	parseOffset,
+	parseBoundary,
+	parseISO,
+}
+
+func parseKeyword(expr string, ref time.Time) (time.Time, bool) {
+	switch expr {
+	case "today", "now":
+		return ref, true
+	case "tomorrow":
+		return ref.AddDate(0, 0, 1), true
+	case "yesterday":
+		return ref.AddDate(0, 0, -1), true
+	}
+	return time.Time{}, false
+}
+
+var weekdays = map[string]time.Weekday{
+	"sunday": time.Sunday, "sun": time.Sunday,
+	"monday": time.Monday, "mon": time.Monday,
+	"tuesday": time.Tuesday, "tue": time.Tuesday, "tues": time.Tuesday,
+	"wednesday": time.Wednesday, "wed": time.Wednesday,
+	"thursday": time.Thursday, "thu": time.Thursday, "thurs": time.Thursday,
+	"friday": time.Friday, "fri": time.Friday,
+	"saturday": time.Saturday, "sat": time.Saturday,
+}
+
+func parseWeekday(expr string, ref time.Time) (time.Time, bool) {
+	modifier, name := "", expr
+	if i := strings.IndexByte(expr, ' '); i >= 0 {
+		modifier, name = expr[:i], expr[i+1:]
+	}
+	wd, ok := weekdays[name]
+	if !ok {
+		return time.Time{}, false
+	}
+
+	ahead := int(wd-ref.Weekday()+7) % 7
+	switch modifier {
+	case "", "this":
+		return ref.AddDate(0, 0, ahead), true
+	case "next":
+		if ahead == 0 {
+			ahead = 7
+		}
+		return ref.AddDate(0, 0, ahead), true
+	case "last":
+		behind := int(ref.Weekday()-wd+7) % 7
+		if behind == 0 {
+			behind = 7
+		}
+		return ref.AddDate(0, 0, -behind), true
+	}
+	return time.Time{}, false
+}
+
+// units maps the names of the units of an offset to the unit's single letter form
+var units = map[string]string{
+	"d": "d", "day": "d", "days": "d",
+	"w": "w", "wk": "w", "wks": "w", "week": "w", "weeks": "w",
+	"m": "m", "mo": "m", "mon": "m", "month": "m", "months": "m",
+	"y": "y", "yr": "y", "yrs": "y", "year": "y", "years": "y",
+}
+
+func parseRelativeUnit(expr string, ref time.Time) (time.Time, bool) {
+	fields := strings.Fields(expr)
+	if len(fields) != 2 {
+		return time.Time{}, false
+	}
+	var n int
+	switch fields[0] {
+	case "next":
+		n = 1
+	case "last":
+		n = -1
+	default:
+		return time.Time{}, false
+	}
+	unit, ok := units[fields[1]]
+	if !ok || fields[1] == "m" || fields[1] == "mon" {
+		return time.Time{}, false
+	}
+	return add(ref, n, unit), true
+}
+
+var (
+	shortOffset = regexp.MustCompile(`^([+-])\s*(\d+)\s*([a-z]+)$`)
+	longOffset  = regexp.MustCompile(`^(in )?(\d+) ([a-z]+)( ago)?$`)
+)
+
+func parseOffset(expr string, ref time.Time) (time.Time, bool) {
+	var sign, digits, name string
+	if match := shortOffset.FindStringSubmatch(expr); match != nil {
+		sign, digits, name = match[1], match[2], match[3]
+	} else if match := longOffset.FindStringSubmatch(expr); match != nil {
+		if match[1] != "" && match[4] != "" {
+			return time.Time{}, false
+		}
+		sign, digits, name = "+", match[2], match[3]
+		if match[4] != "" {
+			sign = "-"
+		}
+	} else {
+		return time.Time{}, false
+	}
+
+	unit, ok := units[name]
+	if !ok {
+		return time.Time{}, false
+	}
+	n, err := strconv.Atoi(digits)
+	if err != nil {
+		return time.Time{}, false
+	}
+	if sign == "-" {
+		n = -n
+	}
+	return add(ref, n, unit), true
+}
+
+var boundary = regexp.MustCompile(`^(start|beginning|end) of (?:(this|next|last) )?(month|year)$`)
+
+func parseBoundary(expr string, ref time.Time) (time.Time, bool) {
+	match := boundary.FindStringSubmatch(expr)
+	if match == nil {
+		return time.Time{}, false
+	}
+	unit := units[match[3]]
+	switch match[2] {
+	case "next":
+		ref = add(ref, 1, unit)
+	case "last":
+		ref = add(ref, -1, unit)
+	}
+
+	year, month, _ := ref.Date()
+	if unit == "y" {
+		if match[1] == "end" {
+			return withDate(ref, year, time.December, 31), true
+		}
+		return withDate(ref, year, time.January, 1), true
+	}
+	if match[1] == "end" {
+		return withDate(ref, year, month, daysIn(year, month)), true
+	}
+	return withDate(ref, year, month, 1), true
+}
+
+var (
+	isoWeek    = regexp.MustCompile(`^(\d{4})-?w(\d{2})(?:-?([1-7]))?$`)
+	isoOrdinal = regexp.MustCompile(`^(\d{4})-(\d{3})$`)
+)
+
+func parseISO(expr string, ref time.Time) (time.Time, bool) {
+	if t, err := time.Parse(time.RFC3339, strings.ToUpper(expr)); err == nil {
+		return t, true
+	}
+	for _, layout := range []string{"2006-01-02", "20060102", "2006-01"} {
+		if t, err := time.Parse(layout, expr); err == nil {
+			return withDate(ref, t.Year(), t.Month(), t.Day()), true
+		}
+	}
+
+	if match := isoWeek.FindStringSubmatch(expr); match != nil {
+		year, _ := strconv.Atoi(match[1])
+		week, _ := strconv.Atoi(match[2])
+		day := 1
+		if match[3] != "" {
+			day, _ = strconv.Atoi(match[3])
+		}
+		if week < 1 || week > isoWeeksIn(year) {
+			return time.Time{}, false
+		}
+		// January 4 is always in week 1
+		jan4 := time.Date(year, time.January, 4, 0, 0, 0, 0, time.UTC)
+		monday := jan4.AddDate(0, 0, -isoWeekday(jan4)+1)
+		t := monday.AddDate(0, 0, (week-1)*7+day-1)
+		return withDate(ref, t.Year(), t.Month(), t.Day()), true
+	}
+
+	if match := isoOrdinal.FindStringSubmatch(expr); match != nil {
+		year, _ := strconv.Atoi(match[1])
+		day, _ := strconv.Atoi(match[2])
+		days := 365
+		if daysIn(year, time.February) == 29 {
+			days = 366
+		}
+		if day < 1 || day > days {
+			return time.Time{}, false
+		}
+		t := time.Date(year, time.January, day, 0, 0, 0, 0, time.UTC)
+		return withDate(ref, t.Year(), t.Month(), t.Day()), true
+	}
+	return time.Time{}, false
+}
+
+// add moves t by n of the unit, keeping the day within the length of the month
+func add(t time.Time, n int, unit string) time.Time {
+	switch unit {
+	case "d":
+		return t.AddDate(0, 0, n)
+	case "w":
+		return t.AddDate(0, 0, 7*n)
+	case "y":
+		n *= 12
+	}
+
+	year, month, day := t.Date()
+	first := time.Date(year, month, 1, 0, 0, 0, 0, time.UTC).AddDate(0, n, 0)
+	if max := daysIn(first.Year(), first.Month()); day > max {
+		day = max
+	}
+	return withDate(t, first.Year(), first.Month(), day)
+}
+
+// withDate returns the given date with the time of day and location of t
+func withDate(t time.Time, year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), t.Location())
+}
+
+// daysIn returns the number of days in the month
+func daysIn(year int, month time.Month) int {
+	return time.Date(year, month+1, 0, 0, 0, 0, 0, time.UTC).Day()
+}
+
+// isoWeekday returns the ISO 8601 number of the weekday of t, from 1 for
+// Monday to 7 for Sunday
+func isoWeekday(t time.Time) int {
+	if t.Weekday() == time.Sunday {
+		return 7
+	}
+	return int(t.Weekday())
+}
+
+// isoWeeksIn returns the number of ISO 8601 weeks in year
+func isoWeeksIn(year int) int {
+	_, week := time.Date(year, time.December, 28, 0, 0, 0, 0, time.UTC).ISOWeek()
+	return week
+}
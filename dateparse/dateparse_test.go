@@ -0,0 +1,123 @@
+package dateparse
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+// ref is Tuesday, October 31 2023
+var ref = time.Date(2023, time.October, 31, 10, 30, 0, 0, time.UTC)
+
+func date(year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, 10, 30, 0, 0, time.UTC)
+}
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		input string
+		want  time.Time
+	}{
+		// keywords
+		{input: "today", want: ref},
+		{input: "now", want: ref},
+		{input: "Tomorrow", want: date(2023, time.November, 1)},
+		{input: " yesterday ", want: date(2023, time.October, 30)},
+
+		// weekdays
+		{input: "tuesday", want: ref},
+		{input: "this tuesday", want: ref},
+		{input: "friday", want: date(2023, time.November, 3)},
+		{input: "fri", want: date(2023, time.November, 3)},
+		{input: "next friday", want: date(2023, time.November, 3)},
+		{input: "next tuesday", want: date(2023, time.November, 7)},
+		{input: "last tuesday", want: date(2023, time.October, 24)},
+		{input: "last sunday", want: date(2023, time.October, 29)},
+		{input: "next  Mon", want: date(2023, time.November, 6)},
+
+		// relative units
+		{input: "next week", want: date(2023, time.November, 7)},
+		{input: "last week", want: date(2023, time.October, 24)},
+		{input: "next month", want: date(2023, time.November, 30)},
+		{input: "last month", want: date(2023, time.September, 30)},
+		{input: "next year", want: date(2024, time.October, 31)},
+
+		// offsets
+		{input: "+3w", want: date(2023, time.November, 21)},
+		{input: "+3 w", want: date(2023, time.November, 21)},
+		{input: "-2d", want: date(2023, time.October, 29)},
+		{input: "+1m", want: date(2023, time.November, 30)},
+		{input: "+4m", want: date(2024, time.February, 29)},
+		{input: "-1y", want: date(2022, time.October, 31)},
+		{input: "+10days", want: date(2023, time.November, 10)},
+		{input: "in 3 days", want: date(2023, time.November, 3)},
+		{input: "3 weeks", want: date(2023, time.November, 21)},
+		{input: "2 years ago", want: date(2021, time.October, 31)},
+		{input: "1 month ago", want: date(2023, time.September, 30)},
+
+		// boundaries
+		{input: "end of month", want: date(2023, time.October, 31)},
+		{input: "start of month", want: date(2023, time.October, 1)},
+		{input: "beginning of this month", want: date(2023, time.October, 1)},
+		{input: "end of next month", want: date(2023, time.November, 30)},
+		{input: "end of last month", want: date(2023, time.September, 30)},
+		{input: "start of year", want: date(2023, time.January, 1)},
+		{input: "end of next year", want: date(2024, time.December, 31)},
+
+		// ISO 8601
+		{input: "2024-01-31", want: date(2024, time.January, 31)},
+		{input: "20240131", want: date(2024, time.January, 31)},
+		{input: "2024-02", want: date(2024, time.February, 1)},
+		{input: "2024-W05-3", want: date(2024, time.January, 31)},
+		{input: "2024w053", want: date(2024, time.January, 31)},
+		{input: "2021-W01", want: date(2021, time.January, 4)},
+		{input: "2020-W53-7", want: date(2021, time.January, 3)},
+		{input: "2024-031", want: date(2024, time.January, 31)},
+		{input: "2024-366", want: date(2024, time.December, 31)},
+		{input: "2024-01-31T08:00:00Z", want: time.Date(2024, time.January, 31, 8, 0, 0, 0, time.UTC)},
+	}
+	for i, test := range tests {
+		got, err := Parse(test.input, ref)
+		if err != nil {
+			t.Errorf("TestParse failure - index: %d - input: '%s' - unexpected error: %v", i, test.input, err)
+			continue
+		}
+		if !got.Equal(test.want) {
+			t.Errorf("TestParse failure - index: %d - input: '%s' - want: '%s' got: '%s'", i, test.input, test.want, got)
+		}
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	tests := []string{
+		"",
+		"   ",
+		"someday",
+		"next",
+		"next fortnight",
+		"+3",
+		"+3q",
+		"in 3 days ago",
+		"2024-13-01",
+		"2023-02-29",
+		"2023-W53",
+		"2023-366",
+		"end of week",
+	}
+	for i, input := range tests {
+		if _, err := Parse(input, ref); !errors.Is(err, ErrUnrecognized) {
+			t.Errorf("TestParseErrors failure - index: %d - input: '%s' - want: '%v' got: '%v'", i, input, ErrUnrecognized, err)
+		}
+	}
+}
+
+func TestParseLocation(t *testing.T) {
+	loc := time.FixedZone("UTC-5", -5*60*60)
+	ref := time.Date(2023, time.October, 31, 23, 0, 0, 0, loc)
+
+	got, err := Parse("tomorrow", ref)
+	want := time.Date(2023, time.November, 1, 23, 0, 0, 0, loc)
+	if err != nil || !got.Equal(want) || got.Location() != loc {
+		t.Errorf("TestParseLocation failure - want: '%s' got: '%s' (%v)", want, got, err)
+	}
+}
@@ -0,0 +1,184 @@
+package datepicker
+
+import (
+	"math"
+	"time"
+
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// DateTimeChangedMsg is sent by `DateTimeModel.Update` when the end user changes
+// the date or the time of day.
+type DateTimeChangedMsg struct {
+	Old time.Time
+	New time.Time
+}
+
+// DateTimeModel is a struct that combines a datepicker and a time picker into a
+// single component whose value is a `time.Time`. It satisfies the `tea.Model`
+// interface.
+//
+// The time picker is shown below the calendar. Pressing the `FocusNext` key
+// binding of the datepicker while the calendar is focused moves the focus to
+// the hours, and pressing the `FocusPrev` key binding of the time picker while
+// the hours are focused moves it back.
+type DateTimeModel struct {
+	// DatePicker picks the date, and its display location is the location of
+	// the combined value
+	DatePicker Model
+
+	// TimePicker picks the time of day
+	TimePicker TimeModel
+
+	// offsetX and offsetY are where the datetime picker is drawn on the screen
+	offsetX, offsetY int
+}
+
+// NewDateTime returns the DateTimeModel of the datetime picker with the
+// calendar focused
+func NewDateTime(t time.Time) DateTimeModel {
+	tp := NewTime(t)
+	tp.Blur()
+	return DateTimeModel{
+		DatePicker: New(t),
+		TimePicker: tp,
+	}
+}
+
+// Init satisfies the `tea.Model` interface. This sends a nil cmd
+func (m DateTimeModel) Init() tea.Cmd {
+	return nil
+}
+
+// Time returns the date of the datepicker at the time of day of the time
+// picker, in the display location of the datepicker. A time of day skipped on
+// that date by a daylight saving change is moved past the gap.
+func (m DateTimeModel) Time() time.Time {
+	d := m.DatePicker.Date()
+	h, min, s := m.TimePicker.Time.Clock()
+	_, offset := m.TimePicker.Time.Zone()
+	wall := time.Date(d.Year, d.Month, d.Day, h, min, s, 0, time.UTC)
+	return wallTime(wall, m.DatePicker.location(), offset, false)
+}
+
+// SetTime sets the date of the datepicker and the time of day of the time picker
+func (m *DateTimeModel) SetTime(t time.Time) {
+	m.DatePicker.SetTime(t)
+	m.TimePicker.SetTime(t.In(m.DatePicker.location()))
+}
+
+// SetOffset tells the datetime picker where the top left corner of its view is
+// drawn on the screen, so that the coordinates of a `tea.MouseMsg` can be
+// mapped onto it
+func (m *DateTimeModel) SetOffset(x, y int) {
+	m.offsetX = x
+	m.offsetY = y
+}
+
+// Blur blurs both the datepicker and the time picker
+func (m *DateTimeModel) Blur() {
+	m.DatePicker.Blur()
+	m.TimePicker.Blur()
+}
+
+// Update changes the state of the datetime picker. Key msgs go to the focused
+// picker only. Update satisfies the `tea.Model` interface.
+func (m DateTimeModel) Update(msg tea.Msg) (DateTimeModel, tea.Cmd) {
+	prev := m.Time()
+	var cmds []tea.Cmd
+
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		var cmd tea.Cmd
+		switch {
+		case m.canFocusTime() && key.Matches(msg, m.DatePicker.KeyMap.FocusNext):
+			m.focusTime()
+		case m.TimePicker.Focused == TimeFocusHour && key.Matches(msg, m.TimePicker.KeyMap.FocusPrev):
+			m.focusDate()
+		case m.TimePicker.Focused != TimeFocusNone:
+			m.TimePicker, cmd = m.TimePicker.Update(msg)
+		default:
+			m.DatePicker, cmd = m.DatePicker.Update(msg)
+		}
+		cmds = append(cmds, cmd)
+
+	case tea.MouseMsg:
+		m.layoutOffsets()
+		if msg.Type == tea.MouseLeft {
+			m.focusClicked(msg)
+		}
+		var dateCmd, timeCmd tea.Cmd
+		m.DatePicker, dateCmd = m.DatePicker.Update(msg)
+		m.TimePicker, timeCmd = m.TimePicker.Update(msg)
+		cmds = append(cmds, dateCmd, timeCmd)
+
+	default:
+		var dateCmd, timeCmd tea.Cmd
+		m.DatePicker, dateCmd = m.DatePicker.Update(msg)
+		m.TimePicker, timeCmd = m.TimePicker.Update(msg)
+		cmds = append(cmds, dateCmd, timeCmd)
+	}
+
+	if next := m.Time(); !next.Equal(prev) {
+		changed := DateTimeChangedMsg{Old: prev, New: next}
+		cmds = append(cmds, func() tea.Msg { return changed })
+	}
+	return m, batch(cmds...)
+}
+
+// canFocusTime reports whether the focus can move from the datepicker to the
+// time picker
+func (m DateTimeModel) canFocusTime() bool {
+	return m.DatePicker.Focused == FocusCalendar && m.DatePicker.ViewMode == ViewMonth && !m.DatePicker.prompting
+}
+
+// focusTime moves the focus from the datepicker to the hours of the time picker
+func (m *DateTimeModel) focusTime() {
+	m.DatePicker.Blur()
+	m.TimePicker.SetFocus(TimeFocusHour)
+}
+
+// focusDate moves the focus from the time picker to the calendar of the datepicker
+func (m *DateTimeModel) focusDate() {
+	m.TimePicker.Blur()
+	m.DatePicker.SetFocus(FocusCalendar)
+}
+
+// focusClicked focuses the picker under a click, so that the click reaches it
+// even when it was blurred
+func (m *DateTimeModel) focusClicked(msg tea.MouseMsg) {
+	date, clock := m.DatePicker.render(), m.TimePicker.render()
+	switch {
+	case date.contains(msg.X-m.DatePicker.offsetX, msg.Y-m.DatePicker.offsetY) && m.DatePicker.Focused == FocusNone:
+		m.focusDate()
+	case clock.contains(msg.X-m.TimePicker.offsetX, msg.Y-m.TimePicker.offsetY) && m.TimePicker.Focused == TimeFocusNone:
+		if _, ok := clock.hit(msg.X-m.TimePicker.offsetX, msg.Y-m.TimePicker.offsetY); ok {
+			m.DatePicker.Blur()
+			m.TimePicker.SetFocus(TimeFocusHour)
+		}
+	}
+}
+
+// layoutOffsets tells the datepicker and time picker where they are drawn
+// within the datetime picker
+func (m *DateTimeModel) layoutOffsets() {
+	dw, dh := lipgloss.Size(m.DatePicker.View())
+	tw := lipgloss.Width(m.TimePicker.View())
+	width := dw
+	if tw > width {
+		width = tw
+	}
+	center := func(w int) int {
+		return int(math.Round(float64(width-w) * float64(lipgloss.Center)))
+	}
+	m.DatePicker.SetOffset(m.offsetX+center(dw), m.offsetY)
+	m.TimePicker.SetOffset(m.offsetX+center(tw), m.offsetY+dh)
+}
+
+// View renders the calendar above the time of day as a multiline string in the
+// bubbletea application. View satisfies the `tea.Model` interface.
+func (m DateTimeModel) View() string {
+	return lipgloss.JoinVertical(lipgloss.Center, m.DatePicker.View(), m.TimePicker.View())
+}
@@ -0,0 +1,91 @@
+package datepicker
+
+import (
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func TestDateTimeFocus(t *testing.T) {
+	model := NewDateTime(teatime)
+
+	model, _ = model.Update(tea.KeyMsg{Type: tea.KeyRight})
+	if want := teatime.AddDate(0, 0, 1); model.Time() != want {
+		t.Errorf("TestDateTimeFocus failure - want: '%s' got: '%s'", want, model.Time())
+	}
+
+	// tab from the calendar focuses the hours
+	model, _ = model.Update(tea.KeyMsg{Type: tea.KeyTab})
+	if model.DatePicker.Focused != FocusNone || model.TimePicker.Focused != TimeFocusHour {
+		t.Fatalf("TestDateTimeFocus failure - want: '%s' '%d' got: '%s' '%d'", FocusNone, TimeFocusHour, model.DatePicker.Focused, model.TimePicker.Focused)
+	}
+
+	var cmd tea.Cmd
+	model, cmd = model.Update(tea.KeyMsg{Type: tea.KeyUp})
+	want := time.Date(2023, time.November, 1, 17, 7, 30, 0, time.UTC)
+	if model.Time() != want {
+		t.Errorf("TestDateTimeFocus failure - want: '%s' got: '%s'", want, model.Time())
+	}
+	var changed bool
+	for _, msg := range collectMsgs(cmd) {
+		if msg, ok := msg.(DateTimeChangedMsg); ok && msg.New == want {
+			changed = true
+		}
+	}
+	if !changed {
+		t.Errorf("TestDateTimeFocus failure - expected a DateTimeChangedMsg for '%s'", want)
+	}
+
+	// shift+tab from the hours focuses the calendar
+	model, _ = model.Update(tea.KeyMsg{Type: tea.KeyShiftTab})
+	if model.DatePicker.Focused != FocusCalendar || model.TimePicker.Focused != TimeFocusNone {
+		t.Errorf("TestDateTimeFocus failure - want: '%s' '%d' got: '%s' '%d'", FocusCalendar, TimeFocusNone, model.DatePicker.Focused, model.TimePicker.Focused)
+	}
+}
+
+func TestDateTimeLocation(t *testing.T) {
+	tokyo := loadLocation(t, "Asia/Tokyo")
+
+	model := NewDateTime(time.Date(2023, time.October, 31, 20, 0, 0, 0, time.UTC))
+	model.DatePicker.SetLocation(tokyo)
+	model.SetTime(time.Date(2023, time.October, 31, 20, 0, 0, 0, time.UTC))
+
+	if want := time.Date(2023, time.November, 1, 5, 0, 0, 0, tokyo); model.Time() != want {
+		t.Errorf("TestDateTimeLocation failure - want: '%s' got: '%s'", want, model.Time())
+	}
+}
+
+func TestDateTimeDaylightSaving(t *testing.T) {
+	ny := loadLocation(t, "America/New_York")
+
+	// 02:30 is skipped on March 12 2023 in New York
+	model := NewDateTime(time.Date(2023, time.March, 11, 2, 30, 0, 0, ny))
+	model.DatePicker.Tomorrow()
+	if want := time.Date(2023, time.March, 12, 3, 30, 0, 0, ny); !model.Time().Equal(want) {
+		t.Errorf("TestDateTimeDaylightSaving failure - want: '%s' got: '%s'", want, model.Time())
+	}
+}
+
+func TestDateTimeMouse(t *testing.T) {
+	model := NewDateTime(teatime)
+	model.SetOffset(2, 1)
+	view := model.View()
+
+	// clicking the minutes focuses the time picker
+	x, y, _ := findText(view, "16:07", 0)
+	model, _ = model.Update(click(x+2+3, y+1))
+	if model.DatePicker.Focused != FocusNone || model.TimePicker.Focused != TimeFocusMinute {
+		t.Fatalf("TestDateTimeMouse failure - want: '%s' '%d' got: '%s' '%d'", FocusNone, TimeFocusMinute, model.DatePicker.Focused, model.TimePicker.Focused)
+	}
+
+	// clicking a date focuses the calendar again
+	x, y, _ = findText(view, " 15 ", 0)
+	model, _ = model.Update(click(x+2+1, y+1))
+	if model.DatePicker.Focused != FocusCalendar || model.TimePicker.Focused != TimeFocusNone {
+		t.Errorf("TestDateTimeMouse failure - want: '%s' '%d' got: '%s' '%d'", FocusCalendar, TimeFocusNone, model.DatePicker.Focused, model.TimePicker.Focused)
+	}
+	if want := time.Date(2023, time.October, 15, 16, 7, 30, 0, time.UTC); model.Time() != want {
+		t.Errorf("TestDateTimeMouse failure - want: '%s' got: '%s'", want, model.Time())
+	}
+}
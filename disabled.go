@@ -0,0 +1,63 @@
+package datepicker
+
+import "time"
+
+// maxDisabledSkip is the number of consecutive disabled dates the cursor will
+// skip over before giving up on a move
+const maxDisabledSkip = 366
+
+// DisabledFunc reports whether the date of t is disabled. It is assigned to
+// `Model.Disabled`.
+type DisabledFunc func(t time.Time) bool
+
+// DisableWeekends is a `DisabledFunc` that disables Saturdays and Sundays
+func DisableWeekends(t time.Time) bool {
+	return t.Weekday() == time.Saturday || t.Weekday() == time.Sunday
+}
+
+// DisableDates returns a `DisabledFunc` that disables each of the given dates,
+// ignoring their time of day
+func DisableDates(dates ...time.Time) DisabledFunc {
+	disabled := make(map[Date]struct{}, len(dates))
+	for _, d := range dates {
+		disabled[DateOf(d)] = struct{}{}
+	}
+	return func(t time.Time) bool {
+		_, ok := disabled[DateOf(t)]
+		return ok
+	}
+}
+
+// IsDisabled reports whether t is disabled by the model's `Disabled` func
+func (m Model) IsDisabled(t time.Time) bool {
+	return m.Disabled != nil && m.Disabled(t)
+}
+
+// IsSelectable reports whether t is within bounds and not disabled
+func (m Model) IsSelectable(t time.Time) bool {
+	return m.InBounds(t) && !m.IsDisabled(t)
+}
+
+// step moves the model's `Time` by the given number of days, repeating the move
+// while it lands on a disabled date. Moves past `MinDate` or `MaxDate` stop at
+// the bound, and the cursor stays put when no enabled date can be reached.
+func (m *Model) step(days int) {
+	c := m.cursor()
+	y, mo, d := c.Date()
+	for i := 1; i <= maxDisabledSkip; i++ {
+		// each move is made from the cursor, so that a time of day skipped by a
+		// daylight saving change on one date is not carried on to the next
+		t := onDate(y, mo, d+i*days, c)
+		if !m.InBounds(t) {
+			t = m.clamp(t)
+			if !m.IsDisabled(t) {
+				m.setCursor(t)
+			}
+			return
+		}
+		if !m.IsDisabled(t) {
+			m.setCursor(t)
+			return
+		}
+	}
+}
@@ -0,0 +1,99 @@
+package datepicker
+
+import (
+	"testing"
+	"time"
+)
+
+func TestDisableWeekends(t *testing.T) {
+	tests := []struct {
+		input time.Time
+		want  bool
+	}{
+		{input: halloween, want: false},                                              // Tuesday
+		{input: time.Date(2023, time.October, 28, 0, 0, 0, 0, time.UTC), want: true}, // Saturday
+		{input: time.Date(2023, time.October, 29, 0, 0, 0, 0, time.UTC), want: true}, // Sunday
+	}
+	for i, test := range tests {
+		if got := DisableWeekends(test.input); test.want != got {
+			t.Errorf("TestDisableWeekends failure - index: %d - want: '%t' got: '%t'", i, test.want, got)
+		}
+	}
+}
+
+func TestDisableDates(t *testing.T) {
+	tests := []struct {
+		input time.Time
+		want  bool
+	}{
+		{input: halloween, want: false},
+		{input: thanksgiving.Add(9 * time.Hour), want: true},
+		{input: xmas, want: true},
+	}
+	disabled := DisableDates(thanksgiving, xmas)
+	for i, test := range tests {
+		if got := disabled(test.input); test.want != got {
+			t.Errorf("TestDisableDates failure - index: %d - want: '%t' got: '%t'", i, test.want, got)
+		}
+	}
+}
+
+func TestNavigationSkipsDisabled(t *testing.T) {
+	friday := time.Date(2023, time.October, 27, 0, 0, 0, 0, time.UTC)
+	monday := time.Date(2023, time.October, 30, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		navigate func(*Model)
+		disabled DisabledFunc
+		input    time.Time
+		want     time.Time
+	}{
+		{name: "Tomorrow", navigate: (*Model).Tomorrow, disabled: DisableWeekends, input: friday, want: monday},
+		{name: "Yesterday", navigate: (*Model).Yesterday, disabled: DisableWeekends, input: monday, want: friday},
+		{name: "NextWeek", navigate: (*Model).NextWeek, disabled: DisableDates(halloween.AddDate(0, 0, 7)), input: halloween, want: halloween.AddDate(0, 0, 14)},
+		{name: "LastWeek", navigate: (*Model).LastWeek, disabled: DisableDates(halloween.AddDate(0, 0, -7)), input: halloween, want: halloween.AddDate(0, 0, -14)},
+		{name: "Tomorrow", navigate: (*Model).Tomorrow, disabled: func(time.Time) bool { return true }, input: halloween, want: halloween},
+	}
+	for i, test := range tests {
+		model := New(test.input)
+		model.Disabled = test.disabled
+		test.navigate(&model)
+		if got := model.Time; test.want != got {
+			t.Errorf("TestNavigationSkipsDisabled failure - index: %d - %s - want: '%s' got: '%s'", i, test.name, test.want, got)
+		}
+	}
+}
+
+func TestNavigationSkipsDisabledWithinBounds(t *testing.T) {
+	model := New(time.Date(2023, time.November, 30, 0, 0, 0, 0, time.UTC))
+	model.SetBounds(octFirst, decFirst)
+	model.Disabled = DisableDates(decFirst)
+
+	model.Tomorrow()
+	if want, got := time.Date(2023, time.November, 30, 0, 0, 0, 0, time.UTC), model.Time; want != got {
+		t.Errorf("TestNavigationSkipsDisabledWithinBounds failure - want: '%s' got: '%s'", want, got)
+	}
+}
+
+func TestSelectDateDisabled(t *testing.T) {
+	model := New(xmas)
+	model.Disabled = DisableDates(xmas)
+
+	model.SelectDate()
+	if model.Selected {
+		t.Errorf("TestSelectDateDisabled failure - expected `SelectDate` to refuse a disabled date")
+	}
+
+	model.SelectionMode = SelectionRange
+	model.SelectDate()
+	if !model.RangeStart.IsZero() {
+		t.Errorf("TestSelectDateDisabled failure - expected `SelectDate` to refuse anchoring a range on a disabled date")
+	}
+
+	model.SelectionMode = SelectionMultiple
+	model.ToggleDate()
+	if model.IsDateSelected(xmas) {
+		t.Errorf("TestSelectDateDisabled failure - expected `ToggleDate` to refuse a disabled date")
+	}
+}
@@ -17,6 +17,7 @@ func initialModel() tea.Model {
 	now := time.Now()
 	dp := datepicker.New(now)
 	dp.SelectDate()
+	dp.QuitOnClose = true
 
 	return model{
 		datepicker: dp,
@@ -28,24 +29,11 @@ func (m model) Init() tea.Cmd {
 }
 
 func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
-	switch msg := msg.(type) {
-	// Is it a key press?
-	case tea.KeyMsg:
-
-		// Cool, what was the actual key pressed?
-		switch msg.String() {
-
-		// These keys should exit the program.
-		case "ctrl+c", "q":
-			return m, tea.Quit
-		default:
-			datepicker, cmd := m.datepicker.Update(msg)
-			m.datepicker = datepicker
-			return m, cmd
-		}
-	}
-
-	return m, nil
+	// The datepicker is the only component, so its close key binding
+	// ("ctrl+c" or "q") quits the program through `QuitOnClose`.
+	datepicker, cmd := m.datepicker.Update(msg)
+	m.datepicker = datepicker
+	return m, cmd
 }
 
 func (m model) View() string {
@@ -55,7 +43,7 @@ func (m model) View() string {
 }
 
 func main() {
-	p := tea.NewProgram(initialModel())
+	p := tea.NewProgram(initialModel(), tea.WithMouseCellMotion())
 	if _, err := p.Run(); err != nil {
 		fmt.Printf("Alas, there's been an error: %v", err)
 		os.Exit(1)
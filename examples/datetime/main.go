@@ -0,0 +1,58 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	datepicker "github.com/ethanefung/bubble-datepicker"
+)
+
+type model struct {
+	picker datepicker.DateTimeModel
+	value  time.Time
+}
+
+func initialModel() tea.Model {
+	picker := datepicker.NewDateTime(time.Now())
+	picker.TimePicker.Clock = datepicker.Clock12
+	picker.TimePicker.MinuteStep = 5
+
+	return model{
+		picker: picker,
+		value:  picker.Time(),
+	}
+}
+
+func (m model) Init() tea.Cmd {
+	return nil
+}
+
+func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case datepicker.DateTimeChangedMsg:
+		m.value = msg.New
+		return m, nil
+	case datepicker.CloseMsg:
+		return m, tea.Quit
+	}
+
+	var cmd tea.Cmd
+	m.picker, cmd = m.picker.Update(msg)
+	return m, cmd
+}
+
+func (m model) View() string {
+	value := lipgloss.NewStyle().Padding(1, 1, 0).Render(m.value.Format(time.RFC1123))
+	return lipgloss.JoinVertical(lipgloss.Left, m.picker.View(), value)
+}
+
+func main() {
+	p := tea.NewProgram(initialModel(), tea.WithMouseCellMotion())
+	if _, err := p.Run(); err != nil {
+		fmt.Printf("Alas, there's been an error: %v", err)
+		os.Exit(1)
+	}
+}
@@ -14,7 +14,7 @@ var docStyle = lipgloss.NewStyle().Margin(1, 2)
 
 type DayItem struct {
 	label string
-	time.Time
+	datepicker.Date
 }
 
 func (d DayItem) String() string {
@@ -26,11 +26,11 @@ func (d DayItem) Title() string {
 }
 
 func (d DayItem) Description() string {
-	return d.Format(time.DateOnly)
+	return d.String()
 }
 
 func (d DayItem) FilterValue() string {
-	return d.Format(time.DateOnly)
+	return d.String()
 }
 
 type model struct {
@@ -40,17 +40,17 @@ type model struct {
 
 func initializeModel() tea.Model {
 	dates := []list.Item{
-		DayItem{"Halloween", time.Date(2023, time.October, 31, 0, 0, 0, 0, time.UTC)},
-		DayItem{"Thanksgiving", time.Date(2023, time.November, 23, 0, 0, 0, 0, time.UTC)},
-		DayItem{"Christmas", time.Date(2023, time.December, 25, 0, 0, 0, 0, time.UTC)},
-		DayItem{"New Years", time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)},
+		DayItem{"Halloween", datepicker.NewDate(2023, time.October, 31)},
+		DayItem{"Thanksgiving", datepicker.NewDate(2023, time.November, 23)},
+		DayItem{"Christmas", datepicker.NewDate(2023, time.December, 25)},
+		DayItem{"New Years", datepicker.NewDate(2024, time.January, 1)},
 	}
 
 	l := list.New(dates, list.NewDefaultDelegate(), 0, 0)
 	dp := datepicker.New(time.Now())
 
 	item := l.SelectedItem().(DayItem) // sad
-	dp.SetTime(item.Time)
+	dp.SetDate(item.Date)
 	dp.SelectDate()
 
 	return model{
@@ -79,7 +79,7 @@ func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	m.holidays, cmd = m.holidays.Update(msg)
 
 	item := m.holidays.SelectedItem().(DayItem) // sad
-	m.datepicker.SetTime(item.Time)
+	m.datepicker.SetDate(item.Date)
 
 	return m, cmd
 }
@@ -60,6 +60,9 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	var cmd tea.Cmd
 
 	switch msg := msg.(type) {
+	case datepicker.CursorMovedMsg:
+		m.input.SetValue(m.datepicker.Date().String())
+		return m, nil
 	case tea.WindowSizeMsg:
 		// TODO figure out how we want to size things
 		// we'll probably want both bubbles to be vertically stacked
@@ -73,11 +76,10 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			if m.focus == FocusInput {
 				m.focus = FocusDatePicker
 				m.input.Blur()
-				m.input.SetValue(m.datepicker.Time.Format(time.DateOnly))
+				m.input.SetValue(m.datepicker.Date().String())
 
 				m.datepicker.SelectDate()
 				m.datepicker.SetFocus(datepicker.FocusHeaderMonth)
-				m.datepicker = m.datepicker
 				return m, nil
 
 			}
@@ -114,9 +116,9 @@ func (m *Model) UpdateInput(msg tea.Msg) (textinput.Model, tea.Cmd) {
 	m.input, cmd = m.input.Update(msg)
 
 	val := m.input.Value()
-	t, err := time.Parse(time.DateOnly, strings.TrimSpace(val))
+	d, err := datepicker.ParseDate(strings.TrimSpace(val))
 	if err == nil {
-		m.datepicker.SetTime(t)
+		m.datepicker.SetDate(d)
 		m.datepicker.SelectDate()
 		m.datepicker.Blur()
 	}
@@ -130,14 +132,9 @@ func (m *Model) UpdateInput(msg tea.Msg) (textinput.Model, tea.Cmd) {
 func (m *Model) UpdateDatepicker(msg tea.Msg) (datepicker.Model, tea.Cmd) {
 	var cmd tea.Cmd
 
-	prev := m.datepicker.Time
-
+	// changes to the date are reported back to Update as a datepicker.CursorMovedMsg
 	m.datepicker, cmd = m.datepicker.Update(msg)
 
-	if prev != m.datepicker.Time {
-		m.input.SetValue(m.datepicker.Time.Format(time.DateOnly))
-	}
-
 	return m.datepicker, cmd
 }
 
@@ -0,0 +1,75 @@
+package datepicker
+
+import "time"
+
+// weekdays returns the seven days of the week in column order for a calendar
+// whose weeks begin on start
+func weekdays(start time.Weekday) []time.Weekday {
+	days := make([]time.Weekday, 7)
+	for i := range days {
+		days[i] = (start + time.Weekday(i)) % 7
+	}
+	return days
+}
+
+// monthGrid returns the weeks needed to display every date of the month. Each
+// week has seven dates beginning on start, so the first and last weeks include
+// dates of the adjacent months. The dates are midnight in loc, or the first
+// moment of the day where midnight is skipped by a daylight saving change.
+func monthGrid(year int, month time.Month, start time.Weekday, loc *time.Location) [][]time.Time {
+	firstDayOfTheMonth := time.Date(year, month, 1, 0, 0, 0, 0, time.UTC)
+	lastDayOfTheMonth := firstDayOfTheMonth.AddDate(0, 1, -1)
+
+	offset := (int(firstDayOfTheMonth.Weekday()) - int(start) + 7) % 7
+	day := firstDayOfTheMonth.AddDate(0, 0, -offset)
+
+	weeks := [][]time.Time{}
+	for !day.After(lastDayOfTheMonth) {
+		week := make([]time.Time, 7)
+		for i := range week {
+			week[i] = startOfDay(day.Year(), day.Month(), day.Day(), loc)
+			day = day.AddDate(0, 0, 1)
+		}
+		weeks = append(weeks, week)
+	}
+	return weeks
+}
+
+// weekNumber returns the number of the week shown in a calendar row. A row that
+// does not begin on the first day of the numbering's week spans two numbered
+// weeks, so the row is numbered after the week holding most of its dates.
+func weekNumber(week []time.Time, numbering WeekNumbering) int {
+	switch numbering {
+	case WeekNumberingISO:
+		// an ISO week is the Monday through Sunday surrounding its Thursday
+		_, n := dayOfWeek(week, time.Thursday).ISOWeek()
+		return n
+	case WeekNumberingUS:
+		// the row holding January 1st is week 1 even when most of it falls in
+		// the year before, and other rows are numbered after their Wednesday
+		for _, d := range week {
+			if d.Month() == time.January && d.Day() == 1 {
+				return 1
+			}
+		}
+		return usWeek(dayOfWeek(week, time.Wednesday))
+	}
+	return 0
+}
+
+// dayOfWeek returns the date in week that falls on day
+func dayOfWeek(week []time.Time, day time.Weekday) time.Time {
+	for _, d := range week {
+		if d.Weekday() == day {
+			return d
+		}
+	}
+	return week[0]
+}
+
+// usWeek returns the US week number of t, where week 1 is the Sunday through
+// Saturday week containing January 1st
+func usWeek(t time.Time) int {
+	jan1 := time.Date(t.Year(), time.January, 1, 0, 0, 0, 0, t.Location())
+	return (t.YearDay()-1+int(jan1.Weekday()))/7 + 1
+}
@@ -0,0 +1,145 @@
+package datepicker
+
+import (
+	"testing"
+	"time"
+)
+
+func TestWeekdays(t *testing.T) {
+	tests := []struct {
+		input time.Weekday
+		want  []time.Weekday
+	}{
+		{input: time.Sunday, want: []time.Weekday{time.Sunday, time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday, time.Saturday}},
+		{input: time.Monday, want: []time.Weekday{time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday, time.Saturday, time.Sunday}},
+		{input: time.Saturday, want: []time.Weekday{time.Saturday, time.Sunday, time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday}},
+	}
+	for i, test := range tests {
+		got := weekdays(test.input)
+		for j := range test.want {
+			if test.want[j] != got[j] {
+				t.Errorf("TestWeekdays failure - index: %d - column: %d - want: '%s' got: '%s'", i, j, test.want[j], got[j])
+			}
+		}
+	}
+}
+
+func TestMonthGrid(t *testing.T) {
+	months := []struct {
+		year  int
+		month time.Month
+	}{
+		{2023, time.October},  // starts on a Sunday
+		{2023, time.November}, // starts on a Wednesday
+		{2023, time.December}, // ends on a Sunday
+		{2024, time.February}, // leap year
+		{2026, time.February}, // starts on a Sunday and spans exactly four weeks
+		{2023, time.April},    // starts on a Saturday
+		{2024, time.September},
+	}
+
+	for _, mo := range months {
+		first := time.Date(mo.year, mo.month, 1, 0, 0, 0, 0, time.UTC)
+		last := first.AddDate(0, 1, -1)
+
+		for start := time.Sunday; start <= time.Saturday; start++ {
+			weeks := monthGrid(mo.year, mo.month, start, time.UTC)
+
+			if got := weeks[0][0].Weekday(); got != start {
+				t.Errorf("TestMonthGrid failure - %s %d - start: %s - want first column: '%s' got: '%s'", mo.month, mo.year, start, start, got)
+			}
+			if got := weeks[0][0]; got.After(first) || !got.After(first.AddDate(0, 0, -7)) {
+				t.Errorf("TestMonthGrid failure - %s %d - start: %s - first week begins on '%s'", mo.month, mo.year, start, got)
+			}
+			lastWeek := weeks[len(weeks)-1]
+			if got := lastWeek[6]; got.Before(last) || !got.Before(last.AddDate(0, 0, 7)) {
+				t.Errorf("TestMonthGrid failure - %s %d - start: %s - last week ends on '%s'", mo.month, mo.year, start, got)
+			}
+
+			want := weeks[0][0]
+			for i, week := range weeks {
+				if len(week) != 7 {
+					t.Fatalf("TestMonthGrid failure - %s %d - start: %s - week %d has %d days", mo.month, mo.year, start, i, len(week))
+				}
+				for _, day := range week {
+					if day != want {
+						t.Fatalf("TestMonthGrid failure - %s %d - start: %s - want: '%s' got: '%s'", mo.month, mo.year, start, want, day)
+					}
+					want = want.AddDate(0, 0, 1)
+				}
+			}
+		}
+	}
+}
+
+func TestMonthGridWeeks(t *testing.T) {
+	tests := []struct {
+		month time.Month
+		year  int
+		start time.Weekday
+		want  int
+	}{
+		{month: time.February, year: 2026, start: time.Sunday, want: 4},
+		{month: time.February, year: 2026, start: time.Monday, want: 5},
+		{month: time.October, year: 2023, start: time.Sunday, want: 5},
+		{month: time.October, year: 2023, start: time.Monday, want: 6},
+		{month: time.December, year: 2023, start: time.Saturday, want: 6},
+	}
+	for i, test := range tests {
+		if got := len(monthGrid(test.year, test.month, test.start, time.UTC)); test.want != got {
+			t.Errorf("TestMonthGridWeeks failure - index: %d - want: %d got: %d", i, test.want, got)
+		}
+	}
+}
+
+func TestUSWeek(t *testing.T) {
+	tests := []struct {
+		input time.Time
+		want  int
+	}{
+		{input: time.Date(2023, time.January, 1, 0, 0, 0, 0, time.UTC), want: 1}, // Sunday
+		{input: time.Date(2023, time.January, 7, 0, 0, 0, 0, time.UTC), want: 1},
+		{input: time.Date(2023, time.January, 8, 0, 0, 0, 0, time.UTC), want: 2},
+		{input: time.Date(2022, time.January, 1, 0, 0, 0, 0, time.UTC), want: 1}, // Saturday
+		{input: time.Date(2022, time.January, 2, 0, 0, 0, 0, time.UTC), want: 2},
+		{input: halloween, want: 44},
+		{input: time.Date(2023, time.December, 31, 0, 0, 0, 0, time.UTC), want: 53},
+	}
+	for i, test := range tests {
+		if got := usWeek(test.input); test.want != got {
+			t.Errorf("TestUSWeek failure - index: %d - want: %d got: %d", i, test.want, got)
+		}
+	}
+}
+
+func TestWeekNumber(t *testing.T) {
+	tests := []struct {
+		year      int
+		month     time.Month
+		start     time.Weekday
+		numbering WeekNumbering
+		want      []int
+	}{
+		{year: 2023, month: time.October, start: time.Monday, numbering: WeekNumberingISO, want: []int{39, 40, 41, 42, 43, 44}},
+		{year: 2023, month: time.October, start: time.Sunday, numbering: WeekNumberingISO, want: []int{40, 41, 42, 43, 44}},
+		{year: 2023, month: time.October, start: time.Saturday, numbering: WeekNumberingISO, want: []int{40, 41, 42, 43, 44}},
+		{year: 2023, month: time.October, start: time.Sunday, numbering: WeekNumberingUS, want: []int{40, 41, 42, 43, 44}},
+		{year: 2021, month: time.January, start: time.Monday, numbering: WeekNumberingISO, want: []int{53, 1, 2, 3, 4}},
+		{year: 2024, month: time.December, start: time.Monday, numbering: WeekNumberingISO, want: []int{48, 49, 50, 51, 52, 1}},
+		{year: 2023, month: time.December, start: time.Sunday, numbering: WeekNumberingUS, want: []int{48, 49, 50, 51, 52, 1}},
+		{year: 2025, month: time.December, start: time.Sunday, numbering: WeekNumberingUS, want: []int{49, 50, 51, 52, 1}},
+		{year: 2026, month: time.January, start: time.Sunday, numbering: WeekNumberingUS, want: []int{1, 2, 3, 4, 5}},
+		{year: 2025, month: time.December, start: time.Monday, numbering: WeekNumberingUS, want: []int{49, 50, 51, 52, 1}},
+	}
+	for i, test := range tests {
+		weeks := monthGrid(test.year, test.month, test.start, time.UTC)
+		if len(weeks) != len(test.want) {
+			t.Fatalf("TestWeekNumber failure - index: %d - want %d weeks got %d", i, len(test.want), len(weeks))
+		}
+		for j, week := range weeks {
+			if got := weekNumber(week, test.numbering); test.want[j] != got {
+				t.Errorf("TestWeekNumber failure - index: %d - row: %d - want: %d got: %d", i, j, test.want[j], got)
+			}
+		}
+	}
+}
@@ -0,0 +1,242 @@
+package datepicker
+
+import (
+	"github.com/charmbracelet/bubbles/help"
+	"github.com/charmbracelet/bubbles/key"
+)
+
+var (
+	_ help.KeyMap = KeyMap{}
+	_ help.KeyMap = Model{}
+	_ help.KeyMap = TimeKeyMap{}
+	_ help.KeyMap = TimeModel{}
+)
+
+// ShortHelp returns the bindings shown in the short help view. It satisfies the
+// `help.KeyMap` interface.
+func (k KeyMap) ShortHelp() []key.Binding {
+	return []key.Binding{k.Up, k.Down, k.Left, k.Right, k.Select, k.Help}
+}
+
+// FullHelp returns the bindings shown in the full help view. It satisfies the
+// `help.KeyMap` interface.
+func (k KeyMap) FullHelp() [][]key.Binding {
+	return [][]key.Binding{
+		{k.Up, k.Down, k.Left, k.Right},
+		{k.FocusNext, k.FocusPrev, k.Today, k.YearView},
+		{k.LastPage, k.NextPage, k.Prompt},
+		{k.Select, k.Toggle, k.Cancel},
+		{k.Quit, k.Help},
+	}
+}
+
+// ShortHelp returns the bindings available to the current focus, described in
+// terms of what they change. It satisfies the `help.KeyMap` interface so that a
+// `help.Model` can render the datepicker's help.
+func (m Model) ShortHelp() []key.Binding {
+	k := m.contextKeyMap()
+	switch {
+	case m.Focused == FocusNone:
+		return nil
+	case m.prompting:
+		return []key.Binding{k.Select, k.Cancel}
+	case m.ViewMode != ViewMonth:
+		return []key.Binding{k.Up, k.Down, k.Left, k.Right, k.Select, k.Help}
+	}
+	switch m.Focused {
+	case FocusHeaderMonth, FocusHeaderYear:
+		return []key.Binding{k.Up, k.Down, k.FocusNext, k.Help}
+	case FocusCalendar:
+		return []key.Binding{k.Up, k.Down, k.Left, k.Right, k.Select, k.Help}
+	}
+	return nil
+}
+
+// FullHelp returns the bindings available to the current focus, described in
+// terms of what they change. It satisfies the `help.KeyMap` interface.
+func (m Model) FullHelp() [][]key.Binding {
+	k := m.contextKeyMap()
+	switch {
+	case m.Focused == FocusNone:
+		return nil
+	case m.prompting:
+		return [][]key.Binding{{k.Select, k.Cancel}}
+	case m.ViewMode == ViewYear:
+		return [][]key.Binding{
+			{k.Up, k.Down, k.Left, k.Right},
+			{k.LastPage, k.NextPage, k.Today},
+			{k.Select, k.YearView},
+			{k.Quit, k.Help},
+		}
+	case m.ViewMode != ViewMonth:
+		return [][]key.Binding{
+			{k.Up, k.Down, k.Left, k.Right},
+			{k.LastPage, k.NextPage, k.Today},
+			{k.Select, k.Cancel},
+			{k.Quit, k.Help},
+		}
+	}
+	switch m.Focused {
+	case FocusHeaderMonth, FocusHeaderYear:
+		return [][]key.Binding{
+			{k.Up, k.Down, k.Left, k.Right},
+			{k.FocusNext, k.FocusPrev, k.Today},
+			{k.Select},
+			{k.Quit, k.Help},
+		}
+	case FocusCalendar:
+		return [][]key.Binding{
+			{k.Up, k.Down, k.Left, k.Right},
+			{k.FocusNext, k.FocusPrev, k.Today, k.YearView},
+			{k.Select, k.Toggle, k.Cancel, k.Prompt},
+			{k.Quit, k.Help},
+		}
+	}
+	return nil
+}
+
+// contextKeyMap returns a copy of the model's `KeyMap` whose help text describes
+// what each binding does for the current focus. Bindings that do nothing for
+// the current focus are disabled so they are left out of the help view.
+func (m Model) contextKeyMap() KeyMap {
+	k := m.KeyMap
+	switch {
+	case m.prompting:
+		k.Select = withHelpDesc(k.Select, "go to date")
+		k.Cancel = withHelpDesc(k.Cancel, "cancel")
+	case m.ViewMode == ViewYear:
+		k.Up = withHelpDesc(k.Up, "last week")
+		k.Down = withHelpDesc(k.Down, "next week")
+		k.Left = withHelpDesc(k.Left, "yesterday")
+		k.Right = withHelpDesc(k.Right, "tomorrow")
+		k.Select = withHelpDesc(k.Select, "month view")
+		k.YearView = withHelpDesc(k.YearView, "month view")
+		k.LastPage = withHelpDesc(k.LastPage, "last year")
+		k.NextPage = withHelpDesc(k.NextPage, "next year")
+	case m.ViewMode == ViewMonthPicker:
+		k.Left = withHelpDesc(k.Left, "last month")
+		k.Right = withHelpDesc(k.Right, "next month")
+		k.Select = withHelpDesc(k.Select, "pick month")
+		k.Cancel = withHelpDesc(k.Cancel, "back")
+		k.LastPage = withHelpDesc(k.LastPage, "last year")
+		k.NextPage = withHelpDesc(k.NextPage, "next year")
+	case m.ViewMode == ViewYearPicker:
+		k.Left = withHelpDesc(k.Left, "last year")
+		k.Right = withHelpDesc(k.Right, "next year")
+		k.Select = withHelpDesc(k.Select, "pick year")
+		k.Cancel = withHelpDesc(k.Cancel, "back")
+		k.LastPage = withHelpDesc(k.LastPage, "last decade")
+		k.NextPage = withHelpDesc(k.NextPage, "next decade")
+	case m.Focused == FocusHeaderMonth:
+		k.Up = withHelpDesc(k.Up, "last month")
+		k.Down = withHelpDesc(k.Down, "next month")
+		k.Left.SetEnabled(false)
+		k.Right = withHelpDesc(k.Right, "focus year")
+		k.FocusNext = withHelpDesc(k.FocusNext, "focus year")
+		k.FocusPrev.SetEnabled(false)
+		k.Select = withHelpDesc(k.Select, "pick month")
+	case m.Focused == FocusHeaderYear:
+		k.Up = withHelpDesc(k.Up, "last year")
+		k.Down = withHelpDesc(k.Down, "next year")
+		k.Left = withHelpDesc(k.Left, "focus month")
+		k.Right.SetEnabled(false)
+		k.FocusNext = withHelpDesc(k.FocusNext, "focus calendar")
+		k.FocusPrev = withHelpDesc(k.FocusPrev, "focus month")
+		k.Select = withHelpDesc(k.Select, "pick year")
+	case m.Focused == FocusCalendar:
+		k.Up = withHelpDesc(k.Up, "last week")
+		k.Down = withHelpDesc(k.Down, "next week")
+		k.Left = withHelpDesc(k.Left, "yesterday")
+		k.Right = withHelpDesc(k.Right, "tomorrow")
+		k.FocusNext.SetEnabled(false)
+		k.FocusPrev = withHelpDesc(k.FocusPrev, "focus year")
+		k.LastPage = withHelpDesc(k.LastPage, "last month")
+		k.NextPage = withHelpDesc(k.NextPage, "next month")
+		switch m.SelectionMode {
+		case SelectionRange:
+			k.Select = withHelpDesc(k.Select, "select range")
+		case SelectionMultiple:
+			k.Select = withHelpDesc(k.Select, "add date")
+		}
+	}
+	if m.Help.ShowAll {
+		k.Help = withHelpDesc(k.Help, "less")
+	}
+	return k
+}
+
+// withHelpDesc returns a copy of b with its help description replaced by desc
+func withHelpDesc(b key.Binding, desc string) key.Binding {
+	b.SetHelp(b.Help().Key, desc)
+	return b
+}
+
+// ShortHelp returns the bindings shown in the short help view. It satisfies the
+// `help.KeyMap` interface.
+func (k TimeKeyMap) ShortHelp() []key.Binding {
+	return []key.Binding{k.Up, k.Down, k.Left, k.Right, k.Help}
+}
+
+// FullHelp returns the bindings shown in the full help view. It satisfies the
+// `help.KeyMap` interface.
+func (k TimeKeyMap) FullHelp() [][]key.Binding {
+	return [][]key.Binding{
+		{k.Up, k.Down, k.Left, k.Right},
+		{k.FocusNext, k.FocusPrev, k.Toggle},
+		{k.Quit, k.Help},
+	}
+}
+
+// ShortHelp returns the bindings available to the focused field of the time
+// picker. It satisfies the `help.KeyMap` interface.
+func (m TimeModel) ShortHelp() []key.Binding {
+	if m.Focused == TimeFocusNone {
+		return nil
+	}
+	return m.contextKeyMap().ShortHelp()
+}
+
+// FullHelp returns the bindings available to the focused field of the time
+// picker. It satisfies the `help.KeyMap` interface.
+func (m TimeModel) FullHelp() [][]key.Binding {
+	if m.Focused == TimeFocusNone {
+		return nil
+	}
+	return m.contextKeyMap().FullHelp()
+}
+
+// contextKeyMap returns a copy of the model's `KeyMap` whose help text describes
+// what each binding does for the focused field
+func (m TimeModel) contextKeyMap() TimeKeyMap {
+	k := m.KeyMap
+	switch m.Focused {
+	case TimeFocusHour:
+		k.Up = withHelpDesc(k.Up, "next hour")
+		k.Down = withHelpDesc(k.Down, "last hour")
+	case TimeFocusMinute:
+		k.Up = withHelpDesc(k.Up, "next minute")
+		k.Down = withHelpDesc(k.Down, "last minute")
+	case TimeFocusSecond:
+		k.Up = withHelpDesc(k.Up, "next second")
+		k.Down = withHelpDesc(k.Down, "last second")
+	case TimeFocusPeriod:
+		k.Up = withHelpDesc(k.Up, "am/pm")
+		k.Down = withHelpDesc(k.Down, "am/pm")
+	}
+	fields := m.fields()
+	if m.Focused == fields[0] {
+		k.Left.SetEnabled(false)
+		k.FocusPrev.SetEnabled(false)
+	}
+	if m.Focused == fields[len(fields)-1] {
+		k.Right.SetEnabled(false)
+		k.FocusNext.SetEnabled(false)
+	}
+	if m.Clock != Clock12 {
+		k.Toggle.SetEnabled(false)
+	}
+	if m.Help.ShowAll {
+		k.Help = withHelpDesc(k.Help, "less")
+	}
+	return k
+}
@@ -0,0 +1,75 @@
+package datepicker
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func helpDescs(bindings []key.Binding) []string {
+	descs := []string{}
+	for _, b := range bindings {
+		if b.Enabled() {
+			descs = append(descs, b.Help().Desc)
+		}
+	}
+	return descs
+}
+
+func TestShortHelp(t *testing.T) {
+	tests := []struct {
+		input Focus
+		want  []string
+	}{
+		{input: FocusNone, want: []string{}},
+		{input: FocusHeaderMonth, want: []string{"last month", "next month", "focus year", "more"}},
+		{input: FocusHeaderYear, want: []string{"last year", "next year", "focus calendar", "more"}},
+		{input: FocusCalendar, want: []string{"last week", "next week", "yesterday", "tomorrow", "select", "more"}},
+	}
+	model := New(halloween)
+	for i, test := range tests {
+		model.SetFocus(test.input)
+		if got := helpDescs(model.ShortHelp()); !reflect.DeepEqual(test.want, got) {
+			t.Errorf("TestShortHelp failure - index: %d - want: '%v' got: '%v'", i, test.want, got)
+		}
+	}
+}
+
+func TestFullHelp(t *testing.T) {
+	model := New(halloween)
+	model.SetFocus(FocusHeaderMonth)
+
+	got := []string{}
+	for _, column := range model.FullHelp() {
+		got = append(got, helpDescs(column)...)
+	}
+	want := []string{"last month", "next month", "focus year", "focus year", "today", "pick month", "close", "more"}
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("TestFullHelp failure - want: '%v' got: '%v'", want, got)
+	}
+
+	// the KeyMap's own help text is left untouched
+	if got := model.KeyMap.Up.Help().Desc; got != "up" {
+		t.Errorf("TestFullHelp failure - want: '%s' got: '%s'", "up", got)
+	}
+}
+
+func TestViewHelp(t *testing.T) {
+	model := New(halloween)
+	if strings.Contains(model.View(), "tomorrow") {
+		t.Errorf("TestViewHelp failure - expected help to be hidden by default")
+	}
+
+	model.ShowHelp = true
+	if !strings.Contains(model.View(), "tomorrow") {
+		t.Errorf("TestViewHelp failure - expected help line in view")
+	}
+
+	model, _ = model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'?'}})
+	if !model.Help.ShowAll || !strings.Contains(model.View(), "clear") {
+		t.Errorf("TestViewHelp failure - expected full help in view")
+	}
+}
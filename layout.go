@@ -0,0 +1,165 @@
+package datepicker
+
+import (
+	"math"
+	"time"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+// targetKind identifies what a clickable region of the view controls
+type targetKind int
+
+const (
+	targetNone targetKind = iota
+	targetDate
+	targetMonth
+	targetYear
+	targetLastMonth
+	targetNextMonth
+	targetLastPage
+	targetNextPage
+	targetHour
+	targetMinute
+	targetSecond
+	targetPeriod
+	targetZone
+)
+
+// target is the element of the view under a clickable region
+type target struct {
+	kind targetKind
+	date time.Time
+
+	// index is the position of a `targetZone` among the matching zones
+	index int
+}
+
+// region is a clickable rectangle of the view, relative to the top left corner
+// of the block that contains it
+type region struct {
+	x, y          int
+	width, height int
+	target        target
+}
+
+// block is a rendered piece of the view along with the clickable regions it
+// contains. Blocks are joined the same way lipgloss joins strings so that the
+// regions stay in step with the rendered output however `Styles` pads it.
+type block struct {
+	view    string
+	regions []region
+}
+
+// plain returns a block without any clickable regions
+func plain(view string) block {
+	return block{view: view}
+}
+
+// clickable returns a block whose whole area is a region for t
+func clickable(view string, t target) block {
+	w, h := lipgloss.Size(view)
+	return block{view: view, regions: []region{{width: w, height: h, target: t}}}
+}
+
+// hit returns the target of the region containing the point x, y
+func (b block) hit(x, y int) (target, bool) {
+	for _, r := range b.regions {
+		if x >= r.x && x < r.x+r.width && y >= r.y && y < r.y+r.height {
+			return r.target, true
+		}
+	}
+	return target{}, false
+}
+
+// contains reports whether the point x, y is within the rendered block
+func (b block) contains(x, y int) bool {
+	w, h := lipgloss.Size(b.view)
+	return x >= 0 && y >= 0 && x < w && y < h
+}
+
+// translated returns the block's regions moved by x, y
+func (b block) translated(x, y int) []region {
+	regions := make([]region, len(b.regions))
+	for i, r := range b.regions {
+		r.x += x
+		r.y += y
+		regions[i] = r
+	}
+	return regions
+}
+
+// joinHorizontal mirrors `lipgloss.JoinHorizontal`, keeping track of regions
+func joinHorizontal(pos lipgloss.Position, blocks ...block) block {
+	views := make([]string, len(blocks))
+	maxHeight := 0
+	for i, b := range blocks {
+		views[i] = b.view
+		if h := lipgloss.Height(b.view); h > maxHeight {
+			maxHeight = h
+		}
+	}
+
+	joined := block{view: lipgloss.JoinHorizontal(pos, views...)}
+	x := 0
+	for _, b := range blocks {
+		w, h := lipgloss.Size(b.view)
+		y := int(math.Round(float64(maxHeight-h) * float64(pos)))
+		joined.regions = append(joined.regions, b.translated(x, y)...)
+		x += w
+	}
+	return joined
+}
+
+// joinVertical mirrors `lipgloss.JoinVertical`, keeping track of regions
+func joinVertical(pos lipgloss.Position, blocks ...block) block {
+	views := make([]string, len(blocks))
+	maxWidth := 0
+	for i, b := range blocks {
+		views[i] = b.view
+		if w := lipgloss.Width(b.view); w > maxWidth {
+			maxWidth = w
+		}
+	}
+
+	joined := block{view: lipgloss.JoinVertical(pos, views...)}
+	y := 0
+	for _, b := range blocks {
+		w, h := lipgloss.Size(b.view)
+		x := int(math.Round(float64(maxWidth-w) * float64(pos)))
+		joined.regions = append(joined.regions, b.translated(x, y)...)
+		y += h
+	}
+	return joined
+}
+
+// styled renders the block with style, moving its regions past the style's
+// margin, border, padding and alignment
+func styled(style lipgloss.Style, b block) block {
+	view := style.Render(b.view)
+	w, h := lipgloss.Size(b.view)
+
+	lineWidth := w + style.GetHorizontalPadding()
+	x := style.GetMarginLeft() + style.GetBorderLeftSize() + style.GetPaddingLeft()
+	if short := style.GetWidth() - lineWidth; short > 0 {
+		switch style.GetAlignHorizontal() {
+		case lipgloss.Right:
+			x += short
+		case lipgloss.Center:
+			x += short / 2
+		}
+	}
+
+	lineHeight := h + style.GetVerticalPadding()
+	y := style.GetMarginTop() + style.GetBorderTopSize() + style.GetPaddingTop()
+	if short := style.GetHeight() - lineHeight; short > 0 {
+		switch style.GetAlignVertical() {
+		case lipgloss.Bottom:
+			y += short
+		case lipgloss.Center:
+			y += short / 2
+		}
+	}
+
+	return block{view: view, regions: b.translated(x, y)}
+}
@@ -0,0 +1,145 @@
+package datepicker
+
+import (
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Locale supplies the month names, weekday abbreviations and header layout used
+// to render the datepicker.
+type Locale struct {
+	// Months are the full month names, starting with January
+	Months [12]string
+
+	// ShortMonths are the abbreviated month names, starting with January
+	ShortMonths [12]string
+
+	// Weekdays are the two column wide weekday abbreviations shown above the
+	// calendar, starting with Sunday
+	Weekdays [7]string
+
+	// YearFirst renders the year before the month in the header
+	YearFirst bool
+
+	// YearSuffix is appended to the year in the header, such as "年"
+	YearSuffix string
+
+	// WeekStart is the first day of the week customary for the locale
+	WeekStart time.Weekday
+}
+
+// MonthName returns the full name of month
+func (l Locale) MonthName(month time.Month) string {
+	return l.Months[month-1]
+}
+
+// ShortMonthName returns the abbreviated name of month
+func (l Locale) ShortMonthName(month time.Month) string {
+	return l.ShortMonths[month-1]
+}
+
+// WeekdayName returns the abbreviation of day shown in the weekday header
+func (l Locale) WeekdayName(day time.Weekday) string {
+	return l.Weekdays[day]
+}
+
+// FormatYear returns the year as it is shown in the header
+func (l Locale) FormatYear(year int) string {
+	return strconv.Itoa(year) + l.YearSuffix
+}
+
+// monthByPrefix returns the first month whose full or short name starts with
+// prefix, ignoring case
+func (l Locale) monthByPrefix(prefix string) (time.Month, bool) {
+	prefix = strings.ToLower(prefix)
+	for i := range l.Months {
+		if strings.HasPrefix(strings.ToLower(l.Months[i]), prefix) || strings.HasPrefix(strings.ToLower(l.ShortMonths[i]), prefix) {
+			return time.Month(i + 1), true
+		}
+	}
+	return 0, false
+}
+
+var (
+	// LocaleEnglish is the default locale of the datepicker
+	LocaleEnglish = Locale{
+		Months:      [12]string{"January", "February", "March", "April", "May", "June", "July", "August", "September", "October", "November", "December"},
+		ShortMonths: [12]string{"Jan", "Feb", "Mar", "Apr", "May", "Jun", "Jul", "Aug", "Sep", "Oct", "Nov", "Dec"},
+		Weekdays:    [7]string{"Su", "Mo", "Tu", "We", "Th", "Fr", "Sa"},
+		WeekStart:   time.Sunday,
+	}
+
+	// LocaleFrench is a French locale with weeks starting on Monday
+	LocaleFrench = Locale{
+		Months:      [12]string{"janvier", "février", "mars", "avril", "mai", "juin", "juillet", "août", "septembre", "octobre", "novembre", "décembre"},
+		ShortMonths: [12]string{"janv.", "févr.", "mars", "avr.", "mai", "juin", "juil.", "août", "sept.", "oct.", "nov.", "déc."},
+		Weekdays:    [7]string{"di", "lu", "ma", "me", "je", "ve", "sa"},
+		WeekStart:   time.Monday,
+	}
+
+	// LocaleGerman is a German locale with weeks starting on Monday
+	LocaleGerman = Locale{
+		Months:      [12]string{"Januar", "Februar", "März", "April", "Mai", "Juni", "Juli", "August", "September", "Oktober", "November", "Dezember"},
+		ShortMonths: [12]string{"Jan", "Feb", "Mär", "Apr", "Mai", "Jun", "Jul", "Aug", "Sep", "Okt", "Nov", "Dez"},
+		Weekdays:    [7]string{"So", "Mo", "Di", "Mi", "Do", "Fr", "Sa"},
+		WeekStart:   time.Monday,
+	}
+
+	// LocaleSpanish is a Spanish locale with weeks starting on Monday
+	LocaleSpanish = Locale{
+		Months:      [12]string{"enero", "febrero", "marzo", "abril", "mayo", "junio", "julio", "agosto", "septiembre", "octubre", "noviembre", "diciembre"},
+		ShortMonths: [12]string{"ene", "feb", "mar", "abr", "may", "jun", "jul", "ago", "sept", "oct", "nov", "dic"},
+		Weekdays:    [7]string{"do", "lu", "ma", "mi", "ju", "vi", "sá"},
+		WeekStart:   time.Monday,
+	}
+
+	// LocaleJapanese is a Japanese locale that renders the year before the month
+	LocaleJapanese = Locale{
+		Months:      [12]string{"1月", "2月", "3月", "4月", "5月", "6月", "7月", "8月", "9月", "10月", "11月", "12月"},
+		ShortMonths: [12]string{"1月", "2月", "3月", "4月", "5月", "6月", "7月", "8月", "9月", "10月", "11月", "12月"},
+		Weekdays:    [7]string{"日", "月", "火", "水", "木", "金", "土"},
+		YearFirst:   true,
+		YearSuffix:  "年",
+		WeekStart:   time.Sunday,
+	}
+)
+
+var (
+	localesMu sync.RWMutex
+	locales   = map[string]Locale{
+		"en": LocaleEnglish,
+		"fr": LocaleFrench,
+		"de": LocaleGerman,
+		"es": LocaleSpanish,
+		"ja": LocaleJapanese,
+	}
+)
+
+// RegisterLocale makes a locale available to `LookupLocale` under the given
+// name, replacing any locale previously registered with that name. Names are
+// matched case insensitively.
+func RegisterLocale(name string, l Locale) {
+	localesMu.Lock()
+	defer localesMu.Unlock()
+	locales[strings.ToLower(name)] = l
+}
+
+// LookupLocale returns the locale registered under name. A name with a region
+// such as "fr-CA" or "de_AT" falls back to its language when the region itself
+// is not registered.
+func LookupLocale(name string) (Locale, bool) {
+	localesMu.RLock()
+	defer localesMu.RUnlock()
+
+	name = strings.ToLower(strings.ReplaceAll(name, "_", "-"))
+	if l, ok := locales[name]; ok {
+		return l, true
+	}
+	if lang, _, found := strings.Cut(name, "-"); found {
+		l, ok := locales[lang]
+		return l, ok
+	}
+	return Locale{}, false
+}
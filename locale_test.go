@@ -0,0 +1,79 @@
+package datepicker
+
+import (
+	"testing"
+	"time"
+)
+
+func TestLookupLocale(t *testing.T) {
+	tests := []struct {
+		input  string
+		want   string
+		wantOk bool
+	}{
+		{input: "en", want: "October", wantOk: true},
+		{input: "fr", want: "octobre", wantOk: true},
+		{input: "DE", want: "Oktober", wantOk: true},
+		{input: "es-MX", want: "octubre", wantOk: true},
+		{input: "ja_JP", want: "10月", wantOk: true},
+		{input: "xx", wantOk: false},
+	}
+	for i, test := range tests {
+		l, ok := LookupLocale(test.input)
+		if ok != test.wantOk {
+			t.Errorf("TestLookupLocale failure - index: %d - want ok: '%t' got: '%t'", i, test.wantOk, ok)
+			continue
+		}
+		if !ok {
+			continue
+		}
+		if got := l.MonthName(time.October); test.want != got {
+			t.Errorf("TestLookupLocale failure - index: %d - want: '%s' got: '%s'", i, test.want, got)
+		}
+	}
+}
+
+func TestRegisterLocale(t *testing.T) {
+	pirate := LocaleEnglish
+	pirate.Weekdays = [7]string{"Sd", "Md", "Td", "Wd", "Hd", "Fd", "Xd"}
+	RegisterLocale("en-Pirate", pirate)
+
+	l, ok := LookupLocale("en-pirate")
+	if !ok {
+		t.Fatalf("TestRegisterLocale failure - expected registered locale to be found")
+	}
+	if got := l.WeekdayName(time.Saturday); got != "Xd" {
+		t.Errorf("TestRegisterLocale failure - want: '%s' got: '%s'", "Xd", got)
+	}
+	if l, _ := LookupLocale("en-US"); l.WeekdayName(time.Saturday) != "Sa" {
+		t.Errorf("TestRegisterLocale failure - expected other regions to fall back to the language")
+	}
+}
+
+func TestFormatYear(t *testing.T) {
+	tests := []struct {
+		input Locale
+		want  string
+	}{
+		{input: LocaleEnglish, want: "2023"},
+		{input: LocaleJapanese, want: "2023年"},
+	}
+	for i, test := range tests {
+		if got := test.input.FormatYear(2023); test.want != got {
+			t.Errorf("TestFormatYear failure - index: %d - want: '%s' got: '%s'", i, test.want, got)
+		}
+	}
+}
+
+func TestSetLocale(t *testing.T) {
+	model := New(halloween)
+	model.SetLocale(LocaleGerman)
+	if model.WeekStart != time.Monday {
+		t.Errorf("TestSetLocale failure - want: '%s' got: '%s'", time.Monday, model.WeekStart)
+	}
+
+	var empty Model
+	if got := empty.locale().MonthName(time.January); got != "January" {
+		t.Errorf("TestSetLocale failure - expected unset locale to fall back to English, got: '%s'", got)
+	}
+}
@@ -0,0 +1,134 @@
+package datepicker
+
+import "time"
+
+// SetLocation sets the time zone the dates of the calendar are shown and
+// navigated in. Passing nil shows dates in the location of `Time`.
+func (m *Model) SetLocation(loc *time.Location) {
+	m.Location = loc
+}
+
+// SetZone moves `Time` into loc, keeping the instant it represents, so that the
+// calendar is shown in loc. A `Location` that is set is replaced by loc.
+func (m *Model) SetZone(loc *time.Location) {
+	m.Time = m.Time.In(loc)
+	if m.Location != nil {
+		m.Location = loc
+	}
+}
+
+// location returns the time zone the dates of the calendar are shown in
+func (m Model) location() *time.Location {
+	if m.Location != nil {
+		return m.Location
+	}
+	return m.Time.Location()
+}
+
+// cursor returns `Time` in the display location. Dates are read from and moved
+// in the cursor so that the highlighted date agrees with the calendar, and so
+// that moving by days keeps the wall clock time of the display location across
+// daylight saving changes.
+func (m Model) cursor() time.Time {
+	return m.Time.In(m.location())
+}
+
+// dateOf returns the date of t in the display location, or the zero `Date` when
+// t is the zero `time.Time`
+func (m Model) dateOf(t time.Time) Date {
+	if t.IsZero() {
+		return Date{}
+	}
+	return DateOf(t.In(m.location()))
+}
+
+// setCursor sets `Time` to the instant of t, keeping the location of `Time`.
+// The day aimed for by moves between months is forgotten.
+func (m *Model) setCursor(t time.Time) {
+	m.Time = t.In(m.Time.Location())
+	m.preferredDay = 0
+}
+
+// onDate returns the time of day of clock on the given date, in the location of
+// clock. Values outside of their usual ranges are normalized the way
+// `time.Date` does. Where a daylight saving change skips the time of day on
+// that date, it is read with the offset from before the change, so that the
+// result still falls on the date rather than on the day before.
+func onDate(year int, month time.Month, day int, clock time.Time) time.Time {
+	date := time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+	year, month, day = date.Date()
+
+	loc := clock.Location()
+	t := time.Date(year, month, day, clock.Hour(), clock.Minute(), clock.Second(), clock.Nanosecond(), loc)
+	if compareDays(t, date) == 0 {
+		return t
+	}
+
+	start := startOfDay(year, month, day, loc)
+	_, before := start.Add(-time.Nanosecond).Zone()
+	wall := time.Date(year, month, day, clock.Hour(), clock.Minute(), clock.Second(), clock.Nanosecond(), time.UTC)
+	t = wall.Add(-time.Duration(before) * time.Second).In(loc)
+	if compareDays(t, date) != 0 {
+		return start
+	}
+	return t
+}
+
+// wallTime returns the time in loc whose wall clock reads the date and time of
+// day of wall, which is given in UTC. Where a daylight saving change repeats
+// that wall clock time, the UTC offset offset is kept when it is one of the
+// choices. Where a change skips it, the result is the first time after the gap,
+// or the last time before it when back is set.
+func wallTime(wall time.Time, loc *time.Location, offset int, back bool) time.Time {
+	offsets := []int{offset}
+	for _, d := range []time.Duration{-24 * time.Hour, 0, 24 * time.Hour} {
+		_, o := wall.Add(d).In(loc).Zone()
+		offsets = append(offsets, o)
+	}
+
+	var found, after, before time.Time
+	for _, o := range offsets {
+		t := wall.Add(-time.Duration(o) * time.Second).In(loc)
+		shown := time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), time.UTC)
+		switch {
+		case shown.Equal(wall):
+			if found.IsZero() {
+				found = t
+			}
+		case shown.After(wall):
+			if after.IsZero() || t.Before(after) {
+				after = t
+			}
+		default:
+			if before.IsZero() || t.After(before) {
+				before = t
+			}
+		}
+	}
+
+	switch {
+	case !found.IsZero():
+		return found
+	case back && !before.IsZero():
+		return before
+	case !after.IsZero():
+		return after
+	}
+	return before
+}
+
+// startOfDay returns the first moment of the date in loc. That is midnight,
+// unless a daylight saving change skips midnight, in which case it is the
+// moment the clocks change.
+func startOfDay(year int, month time.Month, day int, loc *time.Location) time.Time {
+	t := time.Date(year, month, day, 0, 0, 0, 0, loc)
+	date := time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+	for compareDays(t, date) < 0 {
+		_, end := t.ZoneBounds()
+		if end.IsZero() {
+			break
+		}
+		t = end
+	}
+	return t
+}
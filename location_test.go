@@ -0,0 +1,164 @@
+package datepicker
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func loadLocation(t *testing.T, name string) *time.Location {
+	t.Helper()
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		t.Skipf("time zone %s unavailable: %v", name, err)
+	}
+	return loc
+}
+
+func TestLocationNavigation(t *testing.T) {
+	tests := []struct {
+		zone  string
+		start time.Time
+		move  func(*Model)
+		want  time.Time
+	}{
+		// spring forward
+		{zone: "America/New_York", start: time.Date(2023, time.March, 11, 12, 0, 0, 0, time.UTC), move: (*Model).Tomorrow, want: time.Date(2023, time.March, 12, 11, 0, 0, 0, time.UTC)},
+		{zone: "Europe/London", start: time.Date(2023, time.March, 25, 23, 30, 0, 0, time.UTC), move: (*Model).Tomorrow, want: time.Date(2023, time.March, 26, 22, 30, 0, 0, time.UTC)},
+		{zone: "Australia/Sydney", start: time.Date(2023, time.September, 25, 14, 0, 0, 0, time.UTC), move: (*Model).NextWeek, want: time.Date(2023, time.October, 2, 13, 0, 0, 0, time.UTC)},
+		// fall back
+		{zone: "America/New_York", start: time.Date(2023, time.November, 5, 4, 30, 0, 0, time.UTC), move: (*Model).Yesterday, want: time.Date(2023, time.November, 4, 4, 30, 0, 0, time.UTC)},
+		{zone: "Australia/Sydney", start: time.Date(2023, time.March, 31, 13, 30, 0, 0, time.UTC), move: (*Model).NextMonth, want: time.Date(2023, time.April, 30, 14, 30, 0, 0, time.UTC)},
+		// midnight is skipped, so 00:30 is read with the offset from before the change
+		{zone: "America/Santiago", start: time.Date(2023, time.September, 2, 4, 30, 0, 0, time.UTC), move: (*Model).Tomorrow, want: time.Date(2023, time.September, 3, 4, 30, 0, 0, time.UTC)},
+		{zone: "America/Santiago", start: time.Date(2023, time.August, 3, 4, 30, 0, 0, time.UTC), move: (*Model).NextMonth, want: time.Date(2023, time.September, 3, 4, 30, 0, 0, time.UTC)},
+		{zone: "America/Santiago", start: time.Date(2023, time.September, 4, 3, 30, 0, 0, time.UTC), move: (*Model).Yesterday, want: time.Date(2023, time.September, 3, 4, 30, 0, 0, time.UTC)},
+		{zone: "America/Santiago", start: time.Date(2023, time.August, 27, 4, 30, 0, 0, time.UTC), move: (*Model).NextWeek, want: time.Date(2023, time.September, 3, 4, 30, 0, 0, time.UTC)},
+		{zone: "America/Sao_Paulo", start: time.Date(2018, time.November, 3, 3, 30, 0, 0, time.UTC), move: (*Model).Tomorrow, want: time.Date(2018, time.November, 4, 3, 30, 0, 0, time.UTC)},
+		// near midnight the displayed date differs from the date in UTC
+		{zone: "Asia/Tokyo", start: time.Date(2023, time.October, 31, 20, 0, 0, 0, time.UTC), move: (*Model).NextMonth, want: time.Date(2023, time.November, 30, 20, 0, 0, 0, time.UTC)},
+		{zone: "America/Los_Angeles", start: time.Date(2024, time.March, 1, 2, 0, 0, 0, time.UTC), move: (*Model).LastYear, want: time.Date(2023, time.March, 1, 2, 0, 0, 0, time.UTC)},
+	}
+	for i, test := range tests {
+		model := New(test.start)
+		model.SetLocation(loadLocation(t, test.zone))
+		test.move(&model)
+		if !model.Time.Equal(test.want) || model.Time.Location() != time.UTC || model.Date() != DateOf(test.want.In(model.Location)) {
+			t.Errorf("TestLocationNavigation failure - index: %d - %s - want: '%s' got: '%s'", i, test.zone, test.want, model.Time)
+		}
+	}
+}
+
+func TestLocationSetDate(t *testing.T) {
+	santiago := loadLocation(t, "America/Santiago")
+
+	// midnight was skipped on September 3 2023 in Santiago
+	model := New(time.Date(2023, time.September, 1, 0, 30, 0, 0, santiago))
+	model.SetDate(Date{2023, time.September, 3})
+	if got, want := model.Date(), (Date{2023, time.September, 3}); got != want {
+		t.Errorf("TestLocationSetDate failure - want: '%s' got: '%s'", want, got)
+	}
+}
+
+func TestLocationCursor(t *testing.T) {
+	ny := loadLocation(t, "America/New_York")
+
+	// 23:30 on October 30 in New York
+	model := New(time.Date(2023, time.October, 31, 3, 30, 0, 0, time.UTC))
+	model.Selected = true
+	model.SetLocation(ny)
+
+	if _, ok := model.stateTextStyle(time.Date(2023, time.October, 30, 0, 0, 0, 0, ny)); !ok {
+		t.Errorf("TestLocationCursor failure - expected October 30 to be highlighted")
+	}
+	if _, ok := model.stateTextStyle(time.Date(2023, time.October, 31, 0, 0, 0, 0, ny)); ok {
+		t.Errorf("TestLocationCursor failure - expected October 31 not to be highlighted")
+	}
+
+	model.SelectionMode = SelectionMultiple
+	model.ToggleDate()
+	if !model.IsDateSelected(time.Date(2023, time.October, 30, 0, 0, 0, 0, ny)) {
+		t.Errorf("TestLocationCursor failure - want: '%v' got: '%v'", "2023-10-30", model.SelectedDates())
+	}
+
+	model.Now = func() time.Time { return time.Date(2023, time.November, 1, 2, 0, 0, 0, time.UTC) }
+	model.Today()
+	if want := time.Date(2023, time.November, 1, 3, 30, 0, 0, time.UTC); !model.Time.Equal(want) {
+		t.Errorf("TestLocationCursor failure - want: '%s' got: '%s'", want, model.Time)
+	}
+}
+
+func TestLocationSelection(t *testing.T) {
+	ny := loadLocation(t, "America/New_York")
+	// 03:00 UTC on October 31 is 23:00 on October 30 in New York
+	early := time.Date(2023, time.October, 31, 3, 0, 0, 0, time.UTC)
+	oct30 := time.Date(2023, time.October, 30, 0, 0, 0, 0, ny)
+	oct31 := time.Date(2023, time.October, 31, 0, 0, 0, 0, ny)
+
+	model := New(halloween)
+	model.SetLocation(ny)
+	model.SelectionMode = SelectionMultiple
+	model.SetSelectedDates(early)
+	if !model.IsDateSelected(oct30) || model.IsDateSelected(oct31) {
+		t.Errorf("TestLocationSelection failure - want '%s' highlighted got: '%v'", "2023-10-30", model.SelectedDates())
+	}
+	if got, want := model.Dates(), []Date{{2023, time.October, 30}}; !reflect.DeepEqual(got, want) {
+		t.Errorf("TestLocationSelection failure - want: '%v' got: '%v'", want, got)
+	}
+
+	model.SelectionMode = SelectionRange
+	model.SetRange(early, early)
+	if !model.InRange(oct30) || model.InRange(oct31) || !model.isRangeEndpoint(oct30) {
+		t.Errorf("TestLocationSelection failure - want the range on '%s'", "2023-10-30")
+	}
+	if start, end := model.DateRange(); start != (Date{2023, time.October, 30}) || end != start {
+		t.Errorf("TestLocationSelection failure - want: '%s' '%s' got: '%s' '%s'", "2023-10-30", "2023-10-30", start, end)
+	}
+}
+
+func TestLocationGrid(t *testing.T) {
+	tests := []struct {
+		zone  string
+		year  int
+		month time.Month
+	}{
+		// midnight was skipped on November 4 2018 in São Paulo
+		{zone: "America/Sao_Paulo", year: 2018, month: time.November},
+		{zone: "America/Sao_Paulo", year: 2018, month: time.February},
+		{zone: "America/Santiago", year: 2023, month: time.September},
+		{zone: "Europe/Berlin", year: 2023, month: time.October},
+	}
+	for i, test := range tests {
+		loc := loadLocation(t, test.zone)
+		model := New(time.Date(test.year, test.month, 15, 12, 0, 0, 0, loc))
+		weeks := monthGrid(test.year, test.month, model.WeekStart, loc)
+
+		want := time.Date(test.year, test.month, 1, 12, 0, 0, 0, time.UTC)
+		want = want.AddDate(0, 0, -int(want.Weekday()))
+		for _, week := range weeks {
+			for _, day := range week {
+				if !sameDay(day, want) || day.Location() != loc {
+					t.Errorf("TestLocationGrid failure - index: %d - %s - want: '%s' got: '%s'", i, test.zone, want.Format(time.DateOnly), day)
+				}
+				want = want.AddDate(0, 0, 1)
+			}
+		}
+		if want.Month() == test.month {
+			t.Errorf("TestLocationGrid failure - index: %d - %s - grid ends early", i, test.zone)
+		}
+	}
+}
+
+func TestLocationDefault(t *testing.T) {
+	tokyo := loadLocation(t, "Asia/Tokyo")
+
+	// without a Location, dates are shown in the location of Time
+	model := New(time.Date(2023, time.October, 31, 8, 0, 0, 0, tokyo))
+	model.Tomorrow()
+	if want := time.Date(2023, time.November, 1, 8, 0, 0, 0, tokyo); model.Time != want {
+		t.Errorf("TestLocationDefault failure - want: '%s' got: '%s'", want, model.Time)
+	}
+	if got := model.visibleMonths()[0]; got != time.Date(2023, time.November, 1, 0, 0, 0, 0, tokyo) {
+		t.Errorf("TestLocationDefault failure - want: '%s' got: '%s'", "2023-11-01", got)
+	}
+}
@@ -0,0 +1,142 @@
+package datepicker
+
+import (
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// CursorMovedMsg is sent by `Update` when the end user moves the model's `Time`
+// to a different date, month or year. OldDate and NewDate are the dates of Old
+// and New in the display location.
+type CursorMovedMsg struct {
+	Old     time.Time
+	New     time.Time
+	OldDate Date
+	NewDate Date
+}
+
+// DateSelectedMsg is sent by `Update` when the end user selects a date in
+// `SelectionSingle` mode, including the date that is already selected. Moving
+// the cursor while a date is selected moves the selection without sending it.
+// Old is the zero `time.Time` and OldDate the zero `Date` when no date was
+// selected.
+type DateSelectedMsg struct {
+	Old     time.Time
+	New     time.Time
+	OldDate Date
+	NewDate Date
+}
+
+// SelectionClearedMsg is sent by `Update` when the end user clears the
+// selection. Old is the previously selected date, or the start of the previous
+// range in `SelectionRange` mode.
+type SelectionClearedMsg struct {
+	Old     time.Time
+	OldDate Date
+}
+
+// RangeSelectedMsg is sent by `Update` when the end user completes a range
+// selection in `SelectionRange` mode.
+type RangeSelectedMsg struct {
+	Start     time.Time
+	End       time.Time
+	StartDate Date
+	EndDate   Date
+}
+
+// DateToggledMsg is sent by `Update` when the end user toggles a date in or out
+// of the selection set in `SelectionMultiple` mode.
+type DateToggledMsg struct {
+	Time     time.Time
+	Date     Date
+	Selected bool
+}
+
+// CloseMsg is sent by `Update` when the end user presses `KeyMap.Quit`. The
+// parent model decides what closing means, such as blurring the datepicker or
+// quitting the program.
+type CloseMsg struct{}
+
+// changeCmd returns a command producing the messages that describe how the
+// model changed from prev, or nil when nothing changed.
+func (m Model) changeCmd(prev Model) tea.Cmd {
+	var cmds []tea.Cmd
+	for _, msg := range m.changeMsgs(prev) {
+		msg := msg
+		cmds = append(cmds, func() tea.Msg { return msg })
+	}
+
+	return batch(cmds...)
+}
+
+// batch combines cmds like `tea.Batch`, but returns a lone cmd as it is so that
+// its msg reaches the caller directly
+func batch(cmds ...tea.Cmd) tea.Cmd {
+	var valid []tea.Cmd
+	for _, cmd := range cmds {
+		if cmd != nil {
+			valid = append(valid, cmd)
+		}
+	}
+	if len(valid) == 1 {
+		return valid[0]
+	}
+	return tea.Batch(valid...)
+}
+
+// changeMsgs returns the messages that describe how the model changed from prev
+func (m Model) changeMsgs(prev Model) []tea.Msg {
+	var msgs []tea.Msg
+
+	if !m.Time.Equal(prev.Time) {
+		msgs = append(msgs, CursorMovedMsg{Old: prev.Time, New: m.Time, OldDate: prev.dateOf(prev.Time), NewDate: m.dateOf(m.Time)})
+	}
+
+	switch m.SelectionMode {
+	case SelectionSingle:
+		old, selected := prev.selectedTime(), m.selectedTime()
+		if m.selecting && !selected.IsZero() {
+			msgs = append(msgs, DateSelectedMsg{Old: old, New: selected, OldDate: prev.dateOf(old), NewDate: m.dateOf(selected)})
+		}
+		if selected.IsZero() && !old.IsZero() {
+			msgs = append(msgs, SelectionClearedMsg{Old: old, OldDate: prev.dateOf(old)})
+		}
+
+	case SelectionRange:
+		changed := !m.RangeStart.Equal(prev.RangeStart) || !m.RangeEnd.Equal(prev.RangeEnd)
+		if changed && m.RangeComplete() {
+			msgs = append(msgs, RangeSelectedMsg{Start: m.RangeStart, End: m.RangeEnd, StartDate: m.dateOf(m.RangeStart), EndDate: m.dateOf(m.RangeEnd)})
+		}
+		if m.RangeStart.IsZero() && !prev.RangeStart.IsZero() {
+			msgs = append(msgs, SelectionClearedMsg{Old: prev.RangeStart, OldDate: prev.dateOf(prev.RangeStart)})
+		}
+
+	case SelectionMultiple:
+		for _, t := range prev.SelectedDates() {
+			if !m.IsDateSelected(t) {
+				msgs = append(msgs, DateToggledMsg{Time: t, Date: prev.dateOf(t), Selected: false})
+			}
+		}
+		for _, t := range m.SelectedDates() {
+			if !prev.IsDateSelected(t) {
+				msgs = append(msgs, DateToggledMsg{Time: t, Date: m.dateOf(t), Selected: true})
+			}
+		}
+		if len(m.selectedDates) == 0 && len(prev.selectedDates) > 0 {
+			old := prev.SelectedDates()[0]
+			msgs = append(msgs, SelectionClearedMsg{Old: old, OldDate: prev.dateOf(old)})
+		}
+	}
+
+	return msgs
+}
+
+// selectedTime returns the model's `Time` when it is selected in
+// `SelectionSingle` mode, and the zero `time.Time` otherwise
+func (m Model) selectedTime() time.Time {
+	if !m.Selected {
+		return time.Time{}
+	}
+	return m.Time
+}
@@ -0,0 +1,187 @@
+package datepicker
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// collectMsgs runs cmd and returns the messages it produces, flattening batches
+func collectMsgs(cmd tea.Cmd) []tea.Msg {
+	if cmd == nil {
+		return nil
+	}
+	msg := cmd()
+	if batch, ok := msg.(tea.BatchMsg); ok {
+		var msgs []tea.Msg
+		for _, c := range batch {
+			msgs = append(msgs, collectMsgs(c)...)
+		}
+		return msgs
+	}
+	return []tea.Msg{msg}
+}
+
+func TestUpdateMsgs(t *testing.T) {
+	right := tea.KeyMsg{Type: tea.KeyRight}
+	enter := tea.KeyMsg{Type: tea.KeyEnter}
+	space := tea.KeyMsg{Type: tea.KeySpace, Runes: []rune{' '}}
+	esc := tea.KeyMsg{Type: tea.KeyEscape}
+	nov1 := halloween.AddDate(0, 0, 1)
+
+	tests := []struct {
+		setup func(*Model)
+		input tea.KeyMsg
+		want  []tea.Msg
+	}{
+		{
+			input: right,
+			want:  []tea.Msg{CursorMovedMsg{Old: halloween, New: nov1, OldDate: DateOf(halloween), NewDate: DateOf(nov1)}},
+		},
+		{
+			setup: (*Model).SelectDate,
+			input: right,
+			want:  []tea.Msg{CursorMovedMsg{Old: halloween, New: nov1, OldDate: DateOf(halloween), NewDate: DateOf(nov1)}},
+		},
+		{
+			input: enter,
+			want:  []tea.Msg{DateSelectedMsg{New: halloween, NewDate: DateOf(halloween)}},
+		},
+		{
+			setup: (*Model).SelectDate,
+			input: enter,
+			want:  []tea.Msg{DateSelectedMsg{Old: halloween, New: halloween, OldDate: DateOf(halloween), NewDate: DateOf(halloween)}},
+		},
+		{
+			setup: func(m *Model) {
+				m.SelectDate()
+				m.Yesterday()
+			},
+			input: enter,
+			want:  []tea.Msg{DateSelectedMsg{Old: halloween.AddDate(0, 0, -1), New: halloween.AddDate(0, 0, -1), OldDate: DateOf(halloween.AddDate(0, 0, -1)), NewDate: DateOf(halloween.AddDate(0, 0, -1))}},
+		},
+		{
+			setup: func(m *Model) { m.SelectionMode = SelectionMultiple },
+			input: space,
+			want:  []tea.Msg{DateToggledMsg{Time: halloween, Date: DateOf(halloween), Selected: true}},
+		},
+		{
+			input: space,
+			want:  []tea.Msg{DateSelectedMsg{New: halloween, NewDate: DateOf(halloween)}},
+		},
+		{
+			setup: (*Model).SelectDate,
+			input: space,
+			want:  []tea.Msg{SelectionClearedMsg{Old: halloween, OldDate: DateOf(halloween)}},
+		},
+		{
+			setup: (*Model).SelectDate,
+			input: esc,
+			want:  []tea.Msg{SelectionClearedMsg{Old: halloween, OldDate: DateOf(halloween)}},
+		},
+		{
+			setup: func(m *Model) {
+				m.SelectionMode = SelectionRange
+				m.SelectDate()
+			},
+			input: esc,
+			want:  []tea.Msg{SelectionClearedMsg{Old: halloween, OldDate: DateOf(halloween)}},
+		},
+		{
+			setup: (*Model).Blur,
+			input: right,
+			want:  nil,
+		},
+	}
+	for i, test := range tests {
+		model := New(halloween)
+		if test.setup != nil {
+			test.setup(&model)
+		}
+		_, cmd := model.Update(test.input)
+		if got := collectMsgs(cmd); !reflect.DeepEqual(test.want, got) {
+			t.Errorf("TestUpdateMsgs failure - index: %d - want: '%v' got: '%v'", i, test.want, got)
+		}
+	}
+}
+
+func TestChangeMsgsSelectionCleared(t *testing.T) {
+	tests := []struct {
+		mode  SelectionMode
+		setup func(*Model)
+		clear func(*Model)
+		want  []tea.Msg
+	}{
+		{
+			mode:  SelectionSingle,
+			setup: (*Model).SelectDate,
+			clear: (*Model).UnselectDate,
+			want:  []tea.Msg{SelectionClearedMsg{Old: halloween, OldDate: DateOf(halloween)}},
+		},
+		{
+			mode:  SelectionRange,
+			setup: func(m *Model) { m.SetRange(halloween, xmas) },
+			clear: (*Model).ClearRange,
+			want:  []tea.Msg{SelectionClearedMsg{Old: halloween, OldDate: DateOf(halloween)}},
+		},
+		{
+			mode:  SelectionMultiple,
+			setup: func(m *Model) { m.SetSelectedDates(halloween, xmas) },
+			clear: (*Model).ClearSelectedDates,
+			want: []tea.Msg{
+				DateToggledMsg{Time: halloween, Date: DateOf(halloween), Selected: false},
+				DateToggledMsg{Time: xmas, Date: DateOf(xmas), Selected: false},
+				SelectionClearedMsg{Old: halloween, OldDate: DateOf(halloween)},
+			},
+		},
+	}
+	for i, test := range tests {
+		model := New(halloween)
+		model.SelectionMode = test.mode
+		test.setup(&model)
+		prev := model
+		test.clear(&model)
+		if got := model.changeMsgs(prev); !reflect.DeepEqual(test.want, got) {
+			t.Errorf("TestChangeMsgsSelectionCleared failure - index: %d - want: '%v' got: '%v'", i, test.want, got)
+		}
+	}
+}
+
+func TestChangeMsgsRangeSelected(t *testing.T) {
+	model := New(xmas)
+	model.SelectionMode = SelectionRange
+	model.SelectDate()
+
+	prev := model
+	model.SetTime(time.Date(2023, time.December, 31, 0, 0, 0, 0, time.UTC))
+	model.SelectDate()
+
+	want := []tea.Msg{
+		CursorMovedMsg{Old: xmas, New: model.Time, OldDate: DateOf(xmas), NewDate: model.Date()},
+		RangeSelectedMsg{Start: xmas, End: model.Time, StartDate: DateOf(xmas), EndDate: model.Date()},
+	}
+	if got := model.changeMsgs(prev); !reflect.DeepEqual(want, got) {
+		t.Errorf("TestChangeMsgsRangeSelected failure - want: '%v' got: '%v'", want, got)
+	}
+}
+
+func TestUpdateClose(t *testing.T) {
+	q := tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'q'}}
+
+	model := New(halloween)
+	_, cmd := model.Update(q)
+	if cmd == nil {
+		t.Fatalf("TestUpdateClose failure - expected a cmd for the close key binding")
+	}
+	if got, ok := cmd().(CloseMsg); !ok {
+		t.Errorf("TestUpdateClose failure - want: '%v' got: '%v'", CloseMsg{}, got)
+	}
+
+	model.QuitOnClose = true
+	_, cmd = model.Update(q)
+	if got, ok := cmd().(tea.QuitMsg); !ok {
+		t.Errorf("TestUpdateClose failure - want: '%v' got: '%v'", tea.QuitMsg{}, got)
+	}
+}
@@ -0,0 +1,102 @@
+package datepicker
+
+import "time"
+
+// monthCount returns the number of months shown side by side
+func (m Model) monthCount() int {
+	if m.Months < 1 {
+		return 1
+	}
+	return m.Months
+}
+
+// visibleMonths returns the first day of each visible month in order
+func (m Model) visibleMonths() []time.Time {
+	first := m.firstVisibleMonth()
+	months := make([]time.Time, m.monthCount())
+	for i := range months {
+		months[i] = first.AddDate(0, i, 0)
+	}
+	return months
+}
+
+// firstVisibleMonth returns the first day of the first visible month. The
+// visible months stay put while the cursor moves within them and follow the
+// cursor once it leaves them.
+func (m Model) firstVisibleMonth() time.Time {
+	c := m.cursor()
+	cursor := m.firstOfMonth(c.Year(), c.Month())
+	n := m.monthCount()
+
+	first := m.firstMonth
+	if first.IsZero() || compareMonths(cursor, first) < 0 {
+		return cursor
+	}
+	if last := first.AddDate(0, n-1, 0); compareMonths(cursor, last) > 0 {
+		return cursor.AddDate(0, 1-n, 0)
+	}
+	return first
+}
+
+// scrollMonths moves the cursor and the visible months by delta months, so the
+// cursor stays in the same position among the visible months
+func (m *Model) scrollMonths(delta int) {
+	first := m.firstVisibleMonth()
+	before := m.cursor()
+
+	for i := 0; i < delta; i++ {
+		m.NextMonth()
+	}
+	for i := 0; i > delta; i-- {
+		m.LastMonth()
+	}
+
+	if moved := monthsBetween(before, m.cursor()); moved != 0 {
+		m.firstMonth = first.AddDate(0, moved, 0)
+	}
+	m.firstMonth = m.firstVisibleMonth()
+}
+
+// addMonths moves the cursor by n months following `MonthOverflow`, keeping
+// the cursor within bounds
+func (m *Model) addMonths(n int) {
+	if m.MonthOverflow == MonthOverflowNormalize {
+		c := m.cursor()
+		m.setCursor(m.clamp(onDate(c.Year(), c.Month()+time.Month(n), c.Day(), c)))
+		return
+	}
+	m.shiftMonths(n)
+}
+
+// moveToMonth moves the cursor to the same day of the given month, keeping the
+// day within the month's length and the cursor within bounds. A day clamped by
+// an earlier move is aimed for again while the cursor has not moved since.
+func (m *Model) moveToMonth(year int, month time.Month) {
+	c := m.cursor()
+	day := c.Day()
+	if DateOf(c) == m.preferredAt && m.preferredDay > day {
+		day = m.preferredDay
+	}
+
+	d := day
+	if n := daysIn(year, month); d > n {
+		d = n
+	}
+	m.setCursor(m.clamp(onDate(year, month, d, c)))
+	m.preferredDay, m.preferredAt = day, m.Date()
+}
+
+// firstOfMonth returns the first day of the month in the display location
+func (m Model) firstOfMonth(year int, month time.Month) time.Time {
+	return startOfDay(year, month, 1, m.location())
+}
+
+// daysIn returns the number of days in the month
+func daysIn(year int, month time.Month) int {
+	return time.Date(year, month+1, 0, 0, 0, 0, 0, time.UTC).Day()
+}
+
+// monthsBetween returns the number of months from the month of a to the month of b
+func monthsBetween(a, b time.Time) int {
+	return (b.Year()-a.Year())*12 + int(b.Month()-a.Month())
+}
@@ -0,0 +1,240 @@
+package datepicker
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// monthStart returns the first day of the month in UTC
+func monthStart(year int, month time.Month) time.Time {
+	return time.Date(year, month, 1, 0, 0, 0, 0, time.UTC)
+}
+
+func TestVisibleMonths(t *testing.T) {
+	sep := monthStart(2023, time.September)
+	oct := monthStart(2023, time.October)
+	nov := monthStart(2023, time.November)
+	dec := monthStart(2023, time.December)
+
+	tests := []struct {
+		first time.Time
+		input time.Time
+		want  []time.Time
+	}{
+		{input: halloween, want: []time.Time{oct, nov, dec}},
+		{first: sep, input: halloween, want: []time.Time{sep, oct, nov}},
+		{first: sep, input: xmas, want: []time.Time{oct, nov, dec}},
+		{first: dec, input: halloween, want: []time.Time{oct, nov, dec}},
+	}
+	for i, test := range tests {
+		model := New(test.input)
+		model.Months = 3
+		model.firstMonth = test.first
+		got := model.visibleMonths()
+		for j := range test.want {
+			if test.want[j] != got[j] {
+				t.Errorf("TestVisibleMonths failure - index: %d - pane: %d - want: '%s' got: '%s'", i, j, test.want[j], got[j])
+			}
+		}
+	}
+}
+
+func TestVisibleMonthsFollowCursor(t *testing.T) {
+	model := New(halloween)
+	model.Months = 2
+	right := tea.KeyMsg{Type: tea.KeyRight}
+	left := tea.KeyMsg{Type: tea.KeyLeft}
+
+	// moving into the second pane leaves the panes in place
+	model, _ = model.Update(right)
+	if got := model.visibleMonths()[0]; got != monthStart(2023, time.October) {
+		t.Errorf("TestVisibleMonthsFollowCursor failure - want: '%s' got: '%s'", monthStart(2023, time.October), got)
+	}
+
+	// moving back before the first pane scrolls the panes back
+	model.SetTime(time.Date(2023, time.October, 1, 0, 0, 0, 0, time.UTC))
+	model, _ = model.Update(left)
+	if got := model.visibleMonths()[0]; got != monthStart(2023, time.September) {
+		t.Errorf("TestVisibleMonthsFollowCursor failure - want: '%s' got: '%s'", monthStart(2023, time.September), got)
+	}
+}
+
+func TestScrollMonths(t *testing.T) {
+	model := New(thanksgiving)
+	model.Months = 2
+	model.firstMonth = monthStart(2023, time.October)
+
+	model.scrollMonths(1)
+	if want := xmas.AddDate(0, 0, -2); model.Time != want {
+		t.Errorf("TestScrollMonths failure - want: '%s' got: '%s'", want, model.Time)
+	}
+	if got := model.visibleMonths()[0]; got != monthStart(2023, time.November) {
+		t.Errorf("TestScrollMonths failure - want: '%s' got: '%s'", monthStart(2023, time.November), got)
+	}
+}
+
+func TestRenderMonths(t *testing.T) {
+	tests := []struct {
+		months, perRow int
+		wantTitles     []string
+		wantLines      int
+	}{
+		{months: 1, wantTitles: []string{"October 2023"}},
+		{months: 2, wantTitles: []string{"October 2023", "November 2023"}},
+		{months: 3, perRow: 2, wantTitles: []string{"October 2023", "November 2023", "December 2023"}},
+	}
+	for i, test := range tests {
+		model := New(halloween)
+		model.Months = test.months
+		model.MonthsPerRow = test.perRow
+		view := model.View()
+
+		for _, title := range test.wantTitles {
+			if strings.Count(view, title) != 1 {
+				t.Errorf("TestRenderMonths failure - index: %d - expected one '%s' title", i, title)
+			}
+		}
+		if got := strings.Count(view, "<"); got != 1 {
+			t.Errorf("TestRenderMonths failure - index: %d - want 1 previous arrow got: %d", i, got)
+		}
+		if got := strings.Count(view, ">"); got != 1 {
+			t.Errorf("TestRenderMonths failure - index: %d - want 1 next arrow got: %d", i, got)
+		}
+	}
+}
+
+func TestUpdateMouseMonths(t *testing.T) {
+	model := New(halloween)
+	model.Months = 2
+	view := model.View()
+
+	_, headerY, _ := findText(view, "November", 0)
+	x, y, _ := findText(view, " 15 ", headerY+1)
+	// the first " 15 " belongs to October, find the November one on the same line
+	line := strings.Split(view, "\n")[y]
+	x = strings.LastIndex(line, " 15 ")
+
+	model, _ = model.Update(click(x+1, y))
+	if want := time.Date(2023, time.November, 15, 0, 0, 0, 0, time.UTC); model.Time != want {
+		t.Errorf("TestUpdateMouseMonths failure - want: '%s' got: '%s'", want, model.Time)
+	}
+
+	x, y, _ = findText(view, "October", 0)
+	model, _ = model.Update(click(x, y))
+	if want := time.Date(2023, time.October, 15, 0, 0, 0, 0, time.UTC); model.Time != want || model.Focused != FocusHeaderMonth {
+		t.Errorf("TestUpdateMouseMonths failure - want: '%s' got: '%s'", want, model.Time)
+	}
+}
+
+func TestMonthOverflow(t *testing.T) {
+	jan31 := time.Date(2023, time.January, 31, 9, 30, 0, 0, time.UTC)
+	leapDay := time.Date(2024, time.February, 29, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		overflow MonthOverflow
+		input    time.Time
+		moves    []func(*Model)
+		want     []time.Time
+	}{
+		{
+			name:  "clamp remembers the day",
+			input: jan31,
+			moves: []func(*Model){(*Model).NextMonth, (*Model).NextMonth, (*Model).NextMonth, (*Model).LastMonth},
+			want: []time.Time{
+				time.Date(2023, time.February, 28, 9, 30, 0, 0, time.UTC),
+				time.Date(2023, time.March, 31, 9, 30, 0, 0, time.UTC),
+				time.Date(2023, time.April, 30, 9, 30, 0, 0, time.UTC),
+				time.Date(2023, time.March, 31, 9, 30, 0, 0, time.UTC),
+			},
+		},
+		{
+			name:  "clamp forgets the day after other moves",
+			input: jan31,
+			moves: []func(*Model){(*Model).NextMonth, (*Model).Yesterday, (*Model).NextMonth},
+			want: []time.Time{
+				time.Date(2023, time.February, 28, 9, 30, 0, 0, time.UTC),
+				time.Date(2023, time.February, 27, 9, 30, 0, 0, time.UTC),
+				time.Date(2023, time.March, 27, 9, 30, 0, 0, time.UTC),
+			},
+		},
+		{
+			name:  "clamp forgets the day after moving away and back",
+			input: jan31,
+			moves: []func(*Model){(*Model).NextMonth, (*Model).Tomorrow, (*Model).Yesterday, (*Model).NextMonth},
+			want: []time.Time{
+				time.Date(2023, time.February, 28, 9, 30, 0, 0, time.UTC),
+				time.Date(2023, time.March, 1, 9, 30, 0, 0, time.UTC),
+				time.Date(2023, time.February, 28, 9, 30, 0, 0, time.UTC),
+				time.Date(2023, time.March, 28, 9, 30, 0, 0, time.UTC),
+			},
+		},
+		{
+			name:  "clamp forgets the day after setting the time",
+			input: jan31,
+			moves: []func(*Model){
+				(*Model).NextMonth,
+				func(m *Model) { m.SetTime(time.Date(2023, time.February, 28, 9, 30, 0, 0, time.UTC)) },
+				(*Model).NextMonth,
+			},
+			want: []time.Time{
+				time.Date(2023, time.February, 28, 9, 30, 0, 0, time.UTC),
+				time.Date(2023, time.February, 28, 9, 30, 0, 0, time.UTC),
+				time.Date(2023, time.March, 28, 9, 30, 0, 0, time.UTC),
+			},
+		},
+		{
+			name:  "clamp years",
+			input: leapDay,
+			moves: []func(*Model){(*Model).NextYear, (*Model).NextYear, (*Model).NextYear, (*Model).NextYear},
+			want: []time.Time{
+				time.Date(2025, time.February, 28, 0, 0, 0, 0, time.UTC),
+				time.Date(2026, time.February, 28, 0, 0, 0, 0, time.UTC),
+				time.Date(2027, time.February, 28, 0, 0, 0, 0, time.UTC),
+				time.Date(2028, time.February, 29, 0, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name:     "normalize",
+			overflow: MonthOverflowNormalize,
+			input:    jan31,
+			moves:    []func(*Model){(*Model).NextMonth, (*Model).LastMonth},
+			want: []time.Time{
+				time.Date(2023, time.March, 3, 9, 30, 0, 0, time.UTC),
+				time.Date(2023, time.February, 3, 9, 30, 0, 0, time.UTC),
+			},
+		},
+		{
+			name:     "normalize years",
+			overflow: MonthOverflowNormalize,
+			input:    leapDay,
+			moves:    []func(*Model){(*Model).LastYear},
+			want:     []time.Time{time.Date(2023, time.March, 1, 0, 0, 0, 0, time.UTC)},
+		},
+	}
+	for _, test := range tests {
+		model := New(test.input)
+		model.MonthOverflow = test.overflow
+		for i, move := range test.moves {
+			move(&model)
+			if model.Time != test.want[i] {
+				t.Errorf("TestMonthOverflow failure - %s - move: %d - want: '%s' got: '%s'", test.name, i, test.want[i], model.Time)
+			}
+		}
+	}
+}
+
+func TestMonthOverflowKeys(t *testing.T) {
+	model := New(time.Date(2023, time.January, 31, 0, 0, 0, 0, time.UTC))
+	model.SetFocus(FocusHeaderMonth)
+
+	down := tea.KeyMsg{Type: tea.KeyDown}
+	model, _ = model.Update(down)
+	model, _ = model.Update(down)
+	if want := time.Date(2023, time.March, 31, 0, 0, 0, 0, time.UTC); model.Time != want {
+		t.Errorf("TestMonthOverflowKeys failure - want: '%s' got: '%s'", want, model.Time)
+	}
+}
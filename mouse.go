@@ -0,0 +1,76 @@
+package datepicker
+
+import tea "github.com/charmbracelet/bubbletea"
+
+// SetOffset tells the datepicker where the top left corner of its view is drawn
+// on the screen, so that the coordinates of a `tea.MouseMsg` can be mapped onto
+// it. The offset defaults to 0, 0 for a datepicker drawn at the top left.
+func (m *Model) SetOffset(x, y int) {
+	m.offsetX = x
+	m.offsetY = y
+}
+
+// updateMouse handles clicks on the dates and header, and scroll wheel events
+// that change the month
+func (m *Model) updateMouse(msg tea.MouseMsg) {
+	if m.Focused == FocusNone {
+		return
+	}
+
+	layout := m.render()
+	x, y := msg.X-m.offsetX, msg.Y-m.offsetY
+	if !layout.contains(x, y) {
+		return
+	}
+
+	switch msg.Type {
+	case tea.MouseWheelUp:
+		m.page(-1)
+		return
+	case tea.MouseWheelDown:
+		m.page(1)
+		return
+	case tea.MouseLeft:
+		// handled below
+	default:
+		return
+	}
+
+	t, ok := layout.hit(x, y)
+	if !ok {
+		return
+	}
+
+	switch m.ViewMode {
+	case ViewYear:
+		m.clickYearView(t)
+		return
+	case ViewMonthPicker, ViewYearPicker:
+		m.clickPicker(t)
+		return
+	}
+
+	switch t.kind {
+	case targetDate:
+		if !m.IsSelectable(t.date) {
+			return
+		}
+		m.SetFocus(FocusCalendar)
+		m.setCursor(withDate(m.cursor(), t.date))
+		if m.SelectionMode == SelectionMultiple {
+			m.ToggleDate()
+		} else {
+			m.SelectDate()
+		}
+	case targetMonth:
+		m.moveToMonth(t.date.Year(), t.date.Month())
+		m.SetFocus(FocusHeaderMonth)
+	case targetYear:
+		m.moveToMonth(t.date.Year(), t.date.Month())
+		m.SetFocus(FocusHeaderYear)
+	case targetLastMonth:
+		m.scrollMonths(-1)
+	case targetNextMonth:
+		m.scrollMonths(1)
+	}
+}
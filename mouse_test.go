@@ -0,0 +1,177 @@
+package datepicker
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/muesli/termenv"
+)
+
+// findText returns the position of the first occurrence of text in the view,
+// skipping the first skip lines
+func findText(view, text string, skip int) (int, int, bool) {
+	for y, line := range strings.Split(view, "\n") {
+		if y < skip {
+			continue
+		}
+		if i := strings.Index(line, text); i >= 0 {
+			return lipgloss.Width(line[:i]), y, true
+		}
+	}
+	return 0, 0, false
+}
+
+func click(x, y int) tea.MouseMsg {
+	return tea.MouseMsg{X: x, Y: y, Type: tea.MouseLeft}
+}
+
+func TestRenderRegions(t *testing.T) {
+	tests := []struct {
+		name  string
+		setup func(*Model)
+	}{
+		{name: "default", setup: func(m *Model) {}},
+		{name: "week numbers", setup: func(m *Model) { m.WeekNumbering = WeekNumberingISO; m.WeekStart = time.Monday }},
+		{name: "date padding", setup: func(m *Model) { m.Styles.Date = lipgloss.NewStyle().Padding(1, 3) }},
+		{name: "header frame", setup: func(m *Model) {
+			m.Styles.Header = lipgloss.NewStyle().Border(lipgloss.RoundedBorder()).Margin(1, 2).Padding(0, 1).Width(40).Align(lipgloss.Center)
+		}},
+		{name: "header right", setup: func(m *Model) {
+			m.Styles.Header = lipgloss.NewStyle().Width(50).Height(5).Align(lipgloss.Right, lipgloss.Bottom)
+		}},
+	}
+	for _, test := range tests {
+		model := New(halloween)
+		test.setup(&model)
+		layout := model.render()
+		view := layout.view
+
+		_, headerY, _ := findText(view, "October", 0)
+		for day := 1; day <= 31; day++ {
+			x, y, ok := findText(view, fmt.Sprintf(" %02d ", day), headerY+1)
+			if !ok {
+				t.Fatalf("TestRenderRegions failure - %s - could not find day %d", test.name, day)
+			}
+			want := time.Date(2023, time.October, day, 0, 0, 0, 0, time.UTC)
+			got, ok := layout.hit(x+1, y)
+			if !ok || got.kind != targetDate || got.date != want {
+				t.Errorf("TestRenderRegions failure - %s - want: '%s' got: '%v'", test.name, want, got)
+			}
+		}
+
+		for text, kind := range map[string]targetKind{"October": targetMonth, "2023": targetYear, "<": targetLastMonth, ">": targetNextMonth} {
+			x, y, _ := findText(view, text, 0)
+			if got, ok := layout.hit(x, y); !ok || got.kind != kind {
+				t.Errorf("TestRenderRegions failure - %s - %s - want: '%d' got: '%d'", test.name, text, kind, got.kind)
+			}
+		}
+	}
+}
+
+func TestUpdateMouse(t *testing.T) {
+	model := New(halloween)
+	model.SetOffset(10, 5)
+	view := model.View()
+
+	x, y, _ := findText(view, " 15 ", 4)
+	model, _ = model.Update(click(x+1+10, y+5))
+	if want := time.Date(2023, time.October, 15, 0, 0, 0, 0, time.UTC); model.Time != want || !model.Selected {
+		t.Errorf("TestUpdateMouse failure - want: '%s' selected got: '%s' selected: '%t'", want, model.Time, model.Selected)
+	}
+
+	x, y, _ = findText(view, "2023", 0)
+	model, _ = model.Update(click(x+10, y+5))
+	if model.Focused != FocusHeaderYear {
+		t.Errorf("TestUpdateMouse failure - want: '%s' got: '%s'", FocusHeaderYear, model.Focused)
+	}
+
+	x, y, _ = findText(view, ">", 0)
+	model, _ = model.Update(click(x+10, y+5))
+	if want := time.Date(2023, time.November, 15, 0, 0, 0, 0, time.UTC); model.Time != want {
+		t.Errorf("TestUpdateMouse failure - want: '%s' got: '%s'", want, model.Time)
+	}
+
+	model, _ = model.Update(tea.MouseMsg{X: 12, Y: 8, Type: tea.MouseWheelUp})
+	model, _ = model.Update(tea.MouseMsg{X: 12, Y: 8, Type: tea.MouseWheelUp})
+	if want := time.Date(2023, time.September, 15, 0, 0, 0, 0, time.UTC); model.Time != want {
+		t.Errorf("TestUpdateMouse failure - want: '%s' got: '%s'", want, model.Time)
+	}
+
+	// events outside of the datepicker are ignored
+	model, _ = model.Update(tea.MouseMsg{X: 0, Y: 0, Type: tea.MouseWheelDown})
+	if want := time.Date(2023, time.September, 15, 0, 0, 0, 0, time.UTC); model.Time != want {
+		t.Errorf("TestUpdateMouse failure - want: '%s' got: '%s'", want, model.Time)
+	}
+}
+
+func TestUpdateMouseDisabled(t *testing.T) {
+	model := New(halloween)
+	model.Disabled = DisableWeekends
+	view := model.View()
+
+	// October 14th 2023 is a Saturday
+	x, y, _ := findText(view, " 14 ", 4)
+	model, _ = model.Update(click(x+1, y))
+	if model.Time != halloween || model.Selected {
+		t.Errorf("TestUpdateMouseDisabled failure - expected click on a disabled date to be ignored")
+	}
+}
+
+func TestUpdateMouseOutsideDays(t *testing.T) {
+	model := New(halloween)
+	view := model.View()
+
+	// November 4th follows October 31st only when outside days are shown
+	_, y31, _ := findText(view, " 31 ", 4)
+	if _, _, ok := findText(view, " 04 ", y31); ok {
+		t.Fatalf("TestUpdateMouseOutsideDays failure - expected outside days to be hidden by default")
+	}
+
+	model.ShowOutsideDays = true
+	view = model.View()
+	x, y, ok := findText(view, " 04 ", y31)
+	if !ok {
+		t.Fatalf("TestUpdateMouseOutsideDays failure - expected November 4th to be rendered")
+	}
+
+	model, _ = model.Update(click(x+1, y))
+	if want := time.Date(2023, time.November, 4, 0, 0, 0, 0, time.UTC); model.Time != want {
+		t.Errorf("TestUpdateMouseOutsideDays failure - want: '%s' got: '%s'", want, model.Time)
+	}
+}
+
+func TestRenderOutsideDays(t *testing.T) {
+	r := lipgloss.NewRenderer(io.Discard)
+	r.SetColorProfile(termenv.ANSI256)
+
+	model := New(halloween)
+	model.ShowOutsideDays = true
+	model.Now = clock(time.Date(2023, time.November, 2, 12, 0, 0, 0, time.UTC))
+	model.Disabled = DisableDates(time.Date(2023, time.November, 4, 0, 0, 0, 0, time.UTC))
+	model.Styles.Date = r.NewStyle().Padding(0, 1)
+	model.Styles.Text = r.NewStyle().Foreground(lipgloss.Color("247"))
+	model.Styles.OutsideMonthText = r.NewStyle().Foreground(lipgloss.Color("239"))
+	model.Styles.DisabledText = r.NewStyle().Foreground(lipgloss.Color("240")).Strikethrough(true)
+	model.Styles.TodayText = r.NewStyle().Foreground(lipgloss.Color("39")).Underline(true)
+	view := model.View()
+
+	tests := []struct {
+		day   string
+		style lipgloss.Style
+	}{
+		{day: "03", style: model.Styles.OutsideMonthText},
+		{day: "04", style: model.Styles.DisabledText.Copy().Inherit(model.Styles.OutsideMonthText)},
+		{day: "02", style: model.Styles.TodayText.Copy().Inherit(model.Styles.Text)},
+	}
+	for i, test := range tests {
+		want := model.Styles.Date.Copy().Inherit(test.style).Render(test.day)
+		if !strings.Contains(view, want) {
+			t.Errorf("TestRenderOutsideDays failure - index: %d - want: %q in view", i, want)
+		}
+	}
+}
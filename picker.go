@@ -0,0 +1,203 @@
+package datepicker
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// pickerColumns is the number of months or years per row in the pickers
+const pickerColumns = 3
+
+// openPicker shows the month or year picker, remembering the current date so
+// that cancelling the picker can restore it
+func (m *Model) openPicker(v ViewMode) {
+	m.pickerOrigin = m.Time
+	m.SetViewMode(v)
+}
+
+// pick closes the picker, returning to the day grid at the picked date
+func (m *Model) pick() {
+	m.SetViewMode(ViewMonth)
+}
+
+// cancelPicker closes the picker and restores the date it was opened at
+func (m *Model) cancelPicker() {
+	m.Time = m.pickerOrigin
+	m.SetViewMode(ViewMonth)
+}
+
+// updateMonthPicker handles key msgs while the month picker is shown
+func (m *Model) updateMonthPicker(msg tea.KeyMsg) {
+	switch {
+	case key.Matches(msg, m.KeyMap.Up):
+		m.shiftMonths(-pickerColumns)
+	case key.Matches(msg, m.KeyMap.Down):
+		m.shiftMonths(pickerColumns)
+	case key.Matches(msg, m.KeyMap.Left):
+		m.shiftMonths(-1)
+	case key.Matches(msg, m.KeyMap.Right):
+		m.shiftMonths(1)
+	case key.Matches(msg, m.KeyMap.Select):
+		m.pick()
+	case key.Matches(msg, m.KeyMap.Cancel):
+		m.cancelPicker()
+	}
+}
+
+// updateYearPicker handles key msgs while the year picker is shown
+func (m *Model) updateYearPicker(msg tea.KeyMsg) {
+	switch {
+	case key.Matches(msg, m.KeyMap.Up):
+		m.shiftYears(-pickerColumns)
+	case key.Matches(msg, m.KeyMap.Down):
+		m.shiftYears(pickerColumns)
+	case key.Matches(msg, m.KeyMap.Left):
+		m.shiftYears(-1)
+	case key.Matches(msg, m.KeyMap.Right):
+		m.shiftYears(1)
+	case key.Matches(msg, m.KeyMap.Select):
+		m.pick()
+	case key.Matches(msg, m.KeyMap.Cancel):
+		m.cancelPicker()
+	}
+}
+
+// clickPicker handles a click on the month or year picker. Clicking a month or
+// year picks it.
+func (m *Model) clickPicker(t target) {
+	switch t.kind {
+	case targetMonth:
+		m.moveToMonth(t.date.Year(), t.date.Month())
+		m.pick()
+	case targetYear:
+		m.moveToMonth(t.date.Year(), m.cursor().Month())
+		m.pick()
+	case targetLastPage:
+		m.page(-1)
+	case targetNextPage:
+		m.page(1)
+	}
+}
+
+// page moves the view back or forward by n pages: months in the month view,
+// years in the year overview and month picker, and decades in the year picker
+func (m *Model) page(n int) {
+	switch m.ViewMode {
+	case ViewYear, ViewMonthPicker:
+		m.shiftYears(n)
+	case ViewYearPicker:
+		m.shiftYears(10 * n)
+	default:
+		m.scrollMonths(n)
+	}
+}
+
+// shiftMonths moves the cursor by n months, keeping the day within the month
+func (m *Model) shiftMonths(n int) {
+	c := m.cursor()
+	first := m.firstOfMonth(c.Year(), c.Month()).AddDate(0, n, 0)
+	m.moveToMonth(first.Year(), first.Month())
+}
+
+// shiftYears moves the cursor by n years, keeping the day within the month
+func (m *Model) shiftYears(n int) {
+	c := m.cursor()
+	m.moveToMonth(c.Year()+n, c.Month())
+}
+
+// decadeStart returns the first year of the decade containing year
+func decadeStart(year int) int {
+	start := year - year%10
+	if year < 0 && year%10 != 0 {
+		start -= 10
+	}
+	return start
+}
+
+// renderPageHeader renders title between the arrows used to page the year
+// overview and the pickers
+func (m Model) renderPageHeader(title string, canPrev, canNext bool) block {
+	prevArrow := m.Styles.HeaderText.Render("<")
+	if !canPrev {
+		prevArrow = m.Styles.OutOfBoundsText.Render("<")
+	}
+	nextArrow := m.Styles.HeaderText.Render(">")
+	if !canNext {
+		nextArrow = m.Styles.OutOfBoundsText.Render(">")
+	}
+
+	header := joinHorizontal(lipgloss.Top,
+		clickable(prevArrow, target{kind: targetLastPage}),
+		plain(" "),
+		plain(m.Styles.HeaderText.Render(title)),
+		plain(" "),
+		clickable(nextArrow, target{kind: targetNextPage}),
+	)
+	header.view += "\n"
+	return styled(m.Styles.Header, header)
+}
+
+// renderMonthPicker renders the months of the cursor's year as a grid
+func (m Model) renderMonthPicker() block {
+	locale := m.locale()
+	c := m.cursor()
+	year := c.Year()
+
+	rows := []block{m.renderPageHeader(locale.FormatYear(year), m.yearInBounds(year-1), m.yearInBounds(year+1))}
+	for i := 0; i < 12; i += pickerColumns {
+		row := []block{}
+		for month := time.Month(i + 1); month <= time.Month(i+pickerColumns); month++ {
+			first := m.firstOfMonth(year, month)
+			text := fmt.Sprintf("%-3s", locale.ShortMonthName(month))
+			if !m.monthInBounds(first) {
+				row = append(row, plain(m.Styles.PickerCell.Copy().Inherit(m.Styles.OutOfBoundsText).Render(text)))
+				continue
+			}
+			style := m.Styles.Text
+			if month == c.Month() {
+				style = m.Styles.FocusedText
+			}
+			out := m.Styles.PickerCell.Copy().Inherit(style).Render(text)
+			row = append(row, clickable(out, target{kind: targetMonth, date: first}))
+		}
+		rows = append(rows, joinHorizontal(lipgloss.Top, row...))
+	}
+	return joinVertical(lipgloss.Center, rows...)
+}
+
+// renderYearPicker renders the decade of the cursor's year as a grid, along
+// with the last year of the decade before and the first year of the next one
+func (m Model) renderYearPicker() block {
+	locale := m.locale()
+	c := m.cursor()
+	start := decadeStart(c.Year())
+
+	title := locale.FormatYear(start) + "-" + locale.FormatYear(start+9)
+	rows := []block{m.renderPageHeader(title, m.yearInBounds(start-1), m.yearInBounds(start+10))}
+	for i := -1; i < 11; i += pickerColumns {
+		row := []block{}
+		for year := start + i; year < start+i+pickerColumns; year++ {
+			text := fmt.Sprintf("%4s", strconv.Itoa(year))
+			if !m.yearInBounds(year) {
+				row = append(row, plain(m.Styles.PickerCell.Copy().Inherit(m.Styles.OutOfBoundsText).Render(text)))
+				continue
+			}
+			style := m.Styles.Text
+			switch {
+			case year == c.Year():
+				style = m.Styles.FocusedText
+			case year < start || year > start+9:
+				style = m.Styles.OutsideMonthText
+			}
+			out := m.Styles.PickerCell.Copy().Inherit(style).Render(text)
+			row = append(row, clickable(out, target{kind: targetYear, date: m.firstOfMonth(year, time.January)}))
+		}
+		rows = append(rows, joinHorizontal(lipgloss.Top, row...))
+	}
+	return joinVertical(lipgloss.Center, rows...)
+}
@@ -0,0 +1,121 @@
+package datepicker
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func TestMonthPicker(t *testing.T) {
+	tests := []struct {
+		input []tea.KeyMsg
+		want  time.Time
+		mode  ViewMode
+	}{
+		{input: []tea.KeyMsg{{Type: tea.KeyRight}}, want: time.Date(2023, time.November, 30, 0, 0, 0, 0, time.UTC), mode: ViewMonthPicker},
+		{input: []tea.KeyMsg{{Type: tea.KeyUp}}, want: time.Date(2023, time.July, 31, 0, 0, 0, 0, time.UTC), mode: ViewMonthPicker},
+		{input: []tea.KeyMsg{{Type: tea.KeyDown}}, want: time.Date(2024, time.January, 31, 0, 0, 0, 0, time.UTC), mode: ViewMonthPicker},
+		{input: []tea.KeyMsg{{Type: tea.KeyPgUp}}, want: time.Date(2022, time.October, 31, 0, 0, 0, 0, time.UTC), mode: ViewMonthPicker},
+		{input: []tea.KeyMsg{{Type: tea.KeyLeft}, {Type: tea.KeyEnter}}, want: time.Date(2023, time.September, 30, 0, 0, 0, 0, time.UTC), mode: ViewMonth},
+		{input: []tea.KeyMsg{{Type: tea.KeyLeft}, {Type: tea.KeyEsc}}, want: halloween, mode: ViewMonth},
+	}
+	for i, test := range tests {
+		model := New(halloween)
+		model.SetFocus(FocusHeaderMonth)
+		model, _ = model.Update(tea.KeyMsg{Type: tea.KeyEnter})
+		for _, msg := range test.input {
+			model, _ = model.Update(msg)
+		}
+		if model.Time != test.want || model.ViewMode != test.mode {
+			t.Errorf("TestMonthPicker failure - index: %d - want: '%s' %d got: '%s' %d", i, test.want, test.mode, model.Time, model.ViewMode)
+		}
+	}
+}
+
+func TestYearPicker(t *testing.T) {
+	tests := []struct {
+		input []tea.KeyMsg
+		want  int
+		mode  ViewMode
+	}{
+		{input: []tea.KeyMsg{{Type: tea.KeyLeft}}, want: 2022, mode: ViewYearPicker},
+		{input: []tea.KeyMsg{{Type: tea.KeyDown}}, want: 2026, mode: ViewYearPicker},
+		{input: []tea.KeyMsg{{Type: tea.KeyPgUp}, {Type: tea.KeyPgUp}, {Type: tea.KeyPgUp}, {Type: tea.KeyPgUp}}, want: 1983, mode: ViewYearPicker},
+		{input: []tea.KeyMsg{{Type: tea.KeyPgDown}, {Type: tea.KeyEnter}}, want: 2033, mode: ViewMonth},
+		{input: []tea.KeyMsg{{Type: tea.KeyPgDown}, {Type: tea.KeyEsc}}, want: 2023, mode: ViewMonth},
+	}
+	for i, test := range tests {
+		model := New(halloween)
+		model.SetFocus(FocusHeaderYear)
+		model, _ = model.Update(tea.KeyMsg{Type: tea.KeyEnter})
+		for _, msg := range test.input {
+			model, _ = model.Update(msg)
+		}
+		if model.Time.Year() != test.want || model.ViewMode != test.mode {
+			t.Errorf("TestYearPicker failure - index: %d - want: '%d' %d got: '%d' %d", i, test.want, test.mode, model.Time.Year(), model.ViewMode)
+		}
+		if test.mode == ViewMonth && model.Focused != FocusCalendar {
+			t.Errorf("TestYearPicker failure - index: %d - want: '%s' got: '%s'", i, FocusCalendar, model.Focused)
+		}
+	}
+}
+
+func TestDecadeStart(t *testing.T) {
+	tests := []struct {
+		input int
+		want  int
+	}{
+		{input: 2023, want: 2020},
+		{input: 2020, want: 2020},
+		{input: 1999, want: 1990},
+		{input: -5, want: -10},
+	}
+	for i, test := range tests {
+		if got := decadeStart(test.input); test.want != got {
+			t.Errorf("TestDecadeStart failure - index: %d - want: '%d' got: '%d'", i, test.want, got)
+		}
+	}
+}
+
+func TestRenderYearPicker(t *testing.T) {
+	model := New(halloween)
+	model.SetBounds(time.Date(2021, time.March, 1, 0, 0, 0, 0, time.UTC), time.Time{})
+	model.SetViewMode(ViewYearPicker)
+	layout := model.render()
+
+	if !strings.Contains(layout.view, "2020-2029") {
+		t.Errorf("TestRenderYearPicker failure - expected decade in title")
+	}
+	for year := 2019; year <= 2030; year++ {
+		x, y, ok := findText(layout.view, time.Date(year, 1, 1, 0, 0, 0, 0, time.UTC).Format("2006"), 3)
+		if !ok {
+			t.Fatalf("TestRenderYearPicker failure - could not find year %d", year)
+		}
+		_, clickable := layout.hit(x, y)
+		if want := year >= 2021; clickable != want {
+			t.Errorf("TestRenderYearPicker failure - year: %d - want: '%t' got: '%t'", year, want, clickable)
+		}
+	}
+}
+
+func TestMousePicker(t *testing.T) {
+	model := New(halloween)
+	model.SetViewMode(ViewMonthPicker)
+
+	x, y, _ := findText(model.View(), "Feb", 0)
+	model, _ = model.Update(click(x, y))
+	want := time.Date(2023, time.February, 28, 0, 0, 0, 0, time.UTC)
+	if model.ViewMode != ViewMonth || model.Time != want {
+		t.Errorf("TestMousePicker failure - want: '%s' got: '%s'", want, model.Time)
+	}
+
+	model.SetViewMode(ViewYearPicker)
+	x, y, _ = findText(model.View(), "2019", 0)
+	model, _ = model.Update(click(x, y))
+	want = time.Date(2019, time.February, 28, 0, 0, 0, 0, time.UTC)
+	if model.ViewMode != ViewMonth || model.Time != want {
+		t.Errorf("TestMousePicker failure - want: '%s' got: '%s'", want, model.Time)
+	}
+}
@@ -0,0 +1,96 @@
+package datepicker
+
+import (
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/ethanefung/bubble-datepicker/dateparse"
+)
+
+// newPrompt returns the text input used to type date expressions
+func newPrompt() textinput.Model {
+	p := textinput.New()
+	p.Prompt = "/ "
+	p.Placeholder = "next friday, +3w, 2024-01-31"
+	return p
+}
+
+// OpenPrompt shows the prompt for typing a date expression such as "tomorrow",
+// "next friday", "+3w", "end of month" or "2024-01-31". Submitting the prompt
+// moves the cursor to the date, resolved against the current date. See
+// `dateparse.Parse` for the expressions understood.
+func (m *Model) OpenPrompt() tea.Cmd {
+	m.prompting = true
+	m.promptErr = ""
+	m.Prompt.Reset()
+	return m.Prompt.Focus()
+}
+
+// ClosePrompt hides the prompt without moving the cursor
+func (m *Model) ClosePrompt() {
+	m.prompting = false
+	m.promptErr = ""
+	m.Prompt.Blur()
+}
+
+// Prompting reports whether the prompt is shown
+func (m Model) Prompting() bool {
+	return m.prompting
+}
+
+// updatePrompt passes msg to the prompt, reporting whether it was used. The
+// close key binding is left to the datepicker unless it is a key that can be
+// typed.
+func (m *Model) updatePrompt(msg tea.KeyMsg) (bool, tea.Cmd) {
+	switch {
+	case key.Matches(msg, m.KeyMap.Select):
+		m.submitPrompt()
+		return true, nil
+	case key.Matches(msg, m.KeyMap.Cancel):
+		m.ClosePrompt()
+		return true, nil
+	case key.Matches(msg, m.KeyMap.Quit) && msg.Type != tea.KeyRunes:
+		return false, nil
+	}
+
+	var cmd tea.Cmd
+	m.Prompt, cmd = m.Prompt.Update(msg)
+	m.promptErr = ""
+	return true, cmd
+}
+
+// submitPrompt moves the cursor to the date typed into the prompt and closes
+// it. The prompt stays open with an error when the date cannot be parsed or
+// selected.
+func (m *Model) submitPrompt() {
+	t, err := dateparse.Parse(m.Prompt.Value(), m.cursor())
+	if err != nil {
+		m.promptErr = "unrecognized date"
+		return
+	}
+
+	t = withDate(m.cursor(), t.In(m.location()))
+	switch {
+	case !m.InBounds(t):
+		m.promptErr = "date out of range"
+		return
+	case m.IsDisabled(t):
+		m.promptErr = "date is disabled"
+		return
+	}
+
+	m.setCursor(t)
+	m.ClosePrompt()
+	m.SetFocus(FocusCalendar)
+}
+
+// renderPrompt renders the prompt along with the error of the last submission
+func (m Model) renderPrompt() block {
+	rows := []block{plain(m.Styles.Prompt.Render(m.Prompt.View()))}
+	if m.promptErr != "" {
+		rows = append(rows, plain(m.Styles.PromptError.Render(m.promptErr)))
+	}
+	return joinVertical(lipgloss.Left, rows...)
+}
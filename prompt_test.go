@@ -0,0 +1,72 @@
+package datepicker
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// submitPrompt opens the prompt of model, types text into it and submits it
+func submitPrompt(model Model, text string) Model {
+	model, _ = model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'/'}})
+	model, _ = model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(text)})
+	model, _ = model.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	return model
+}
+
+func TestPrompt(t *testing.T) {
+	tests := []struct {
+		input     string
+		want      time.Time
+		prompting bool
+	}{
+		{input: "tomorrow", want: halloween.AddDate(0, 0, 1), prompting: false},
+		{input: "next friday", want: time.Date(2023, time.November, 3, 0, 0, 0, 0, time.UTC), prompting: false},
+		{input: "+3w", want: time.Date(2023, time.November, 21, 0, 0, 0, 0, time.UTC), prompting: false},
+		{input: "end of next month", want: time.Date(2023, time.November, 30, 0, 0, 0, 0, time.UTC), prompting: false},
+		{input: "2024-01-31T23:30:00+05:00", want: time.Date(2024, time.January, 31, 0, 0, 0, 0, time.UTC), prompting: false},
+		{input: "quarter past", want: halloween, prompting: true},
+		{input: "2099-01-01", want: halloween, prompting: true},
+	}
+	for i, test := range tests {
+		model := New(halloween)
+		model.SetBounds(time.Time{}, time.Date(2030, time.January, 1, 0, 0, 0, 0, time.UTC))
+		model.SetFocus(FocusHeaderMonth)
+
+		model = submitPrompt(model, test.input)
+		if model.Time != test.want || model.Prompting() != test.prompting {
+			t.Errorf("TestPrompt failure - index: %d - want: '%s' '%t' got: '%s' '%t'", i, test.want, test.prompting, model.Time, model.Prompting())
+		}
+		if !test.prompting && model.Focused != FocusCalendar {
+			t.Errorf("TestPrompt failure - index: %d - want: '%s' got: '%s'", i, FocusCalendar, model.Focused)
+		}
+	}
+}
+
+func TestPromptView(t *testing.T) {
+	model := New(halloween)
+	model.ShowHelp = true
+	model = submitPrompt(model, "someday")
+
+	view := model.View()
+	if !strings.Contains(view, "someday") || !strings.Contains(view, "unrecognized date") {
+		t.Errorf("TestPromptView failure - expected prompt and error in view")
+	}
+	if !strings.Contains(view, "go to date") || strings.Contains(view, "tomorrow") {
+		t.Errorf("TestPromptView failure - expected prompt help in view")
+	}
+
+	// typed keys go to the prompt rather than the calendar
+	model, _ = model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'q'}})
+	model, _ = model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'t'}})
+	if model.Time != halloween || model.Prompt.Value() != "somedayqt" {
+		t.Errorf("TestPromptView failure - want: '%s' got: '%s'", "somedayqt", model.Prompt.Value())
+	}
+
+	model, _ = model.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	if model.Prompting() || strings.Contains(model.View(), "someday") {
+		t.Errorf("TestPromptView failure - expected prompt to be closed")
+	}
+}
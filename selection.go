@@ -0,0 +1,214 @@
+package datepicker
+
+import (
+	"sort"
+	"time"
+)
+
+// SetRange sets the model's `RangeStart` and `RangeEnd`. The dates are swapped
+// when end comes before start in the display location.
+func (m *Model) SetRange(start, end time.Time) {
+	if m.compareDates(end, start) < 0 {
+		start, end = end, start
+	}
+	m.RangeStart = start
+	m.RangeEnd = end
+	m.Selected = true
+}
+
+// DateRange returns the dates of `RangeStart` and `RangeEnd` in the display
+// location. Either is the zero `Date` while it is unset.
+func (m Model) DateRange() (start, end Date) {
+	return m.dateOf(m.RangeStart), m.dateOf(m.RangeEnd)
+}
+
+// SetDateRange sets the model's `RangeStart` and `RangeEnd` to start and end in
+// the display location, keeping the time of day of `Time`. The dates are
+// swapped when end comes before start.
+func (m *Model) SetDateRange(start, end Date) {
+	c := m.cursor()
+	m.SetRange(start.At(c), end.At(c))
+}
+
+// ClearRange resets the model's `RangeStart` and `RangeEnd`
+func (m *Model) ClearRange() {
+	m.RangeStart = time.Time{}
+	m.RangeEnd = time.Time{}
+	m.Selected = false
+}
+
+// RangeComplete reports whether both the start and end of the range are set
+func (m Model) RangeComplete() bool {
+	return !m.RangeStart.IsZero() && !m.RangeEnd.IsZero()
+}
+
+// InRange reports whether t falls on or between the range start and end, with
+// all of them read as dates in the display location. While only the start is
+// anchored, the date under the cursor is used as the end.
+func (m Model) InRange(t time.Time) bool {
+	start, end, ok := m.rangeBounds()
+	if !ok {
+		return false
+	}
+	return m.compareDates(t, start) >= 0 && m.compareDates(t, end) <= 0
+}
+
+func (m Model) isRangeEndpoint(t time.Time) bool {
+	start, end, ok := m.rangeBounds()
+	if !ok {
+		return false
+	}
+	return m.compareDates(t, start) == 0 || m.compareDates(t, end) == 0
+}
+
+// rangeBounds returns the ordered bounds of the range that should be highlighted
+func (m Model) rangeBounds() (time.Time, time.Time, bool) {
+	if m.RangeStart.IsZero() {
+		return time.Time{}, time.Time{}, false
+	}
+	start, end := m.RangeStart, m.RangeEnd
+	if end.IsZero() {
+		end = m.cursor()
+	}
+	if m.compareDates(end, start) < 0 {
+		start, end = end, start
+	}
+	return start, end, true
+}
+
+func (m *Model) selectRangeDate() {
+	if m.RangeStart.IsZero() || m.RangeComplete() {
+		m.RangeStart = m.cursor()
+		m.RangeEnd = time.Time{}
+		m.Selected = false
+		return
+	}
+	m.SetRange(m.RangeStart, m.cursor())
+}
+
+// ToggleDate selects the current date, or unselects it when it is already
+// selected. In `SelectionMultiple` mode the current date is added to or removed
+// from the selection set, and in `SelectionRange` mode toggling behaves like
+// `SelectDate`. Disabled dates and dates outside of `MinDate` and `MaxDate`
+// cannot be selected.
+func (m *Model) ToggleDate() {
+	switch m.SelectionMode {
+	case SelectionRange:
+		m.SelectDate()
+		return
+	case SelectionSingle:
+		if m.Selected {
+			m.UnselectDate()
+		} else {
+			m.SelectDate()
+		}
+		return
+	}
+
+	if c := m.cursor(); m.IsDateSelected(c) {
+		m.setDateSelected(c, false)
+	} else if m.IsSelectable(c) {
+		m.setDateSelected(c, true)
+	}
+}
+
+// IsDateSelected reports whether the date of t in the display location is in
+// the selection set used by `SelectionMultiple` mode
+func (m Model) IsDateSelected(t time.Time) bool {
+	_, ok := m.selectedDates[m.dateOf(t)]
+	return ok
+}
+
+// SelectedDates returns the selection set used by `SelectionMultiple` mode in
+// chronological order
+func (m Model) SelectedDates() []time.Time {
+	dates := m.Dates()
+	times := make([]time.Time, len(dates))
+	for i, d := range dates {
+		times[i] = m.selectedDates[d]
+	}
+	return times
+}
+
+// SetSelectedDates replaces the selection set used by `SelectionMultiple` mode.
+// The dates are read in the display location.
+func (m *Model) SetSelectedDates(dates ...time.Time) {
+	m.selectedDates = make(map[Date]time.Time, len(dates))
+	for _, t := range dates {
+		m.selectedDates[m.dateOf(t)] = t
+	}
+	m.Selected = len(m.selectedDates) > 0
+}
+
+// Dates returns the selection set used by `SelectionMultiple` mode as dates in
+// the display location, in chronological order
+func (m Model) Dates() []Date {
+	dates := make([]Date, 0, len(m.selectedDates))
+	for d := range m.selectedDates {
+		dates = append(dates, d)
+	}
+	sort.Slice(dates, func(i, j int) bool {
+		return dates[i].Before(dates[j])
+	})
+	return dates
+}
+
+// SetDates replaces the selection set used by `SelectionMultiple` mode with
+// dates in the display location, keeping the time of day of `Time`
+func (m *Model) SetDates(dates ...Date) {
+	c := m.cursor()
+	times := make([]time.Time, len(dates))
+	for i, d := range dates {
+		times[i] = d.At(c)
+	}
+	m.SetSelectedDates(times...)
+}
+
+// ClearSelectedDates empties the selection set used by `SelectionMultiple` mode
+func (m *Model) ClearSelectedDates() {
+	m.SetSelectedDates()
+}
+
+// setDateSelected adds or removes t from the selection set. The set is copied
+// before it is modified so that earlier copies of the model are left untouched.
+func (m *Model) setDateSelected(t time.Time, selected bool) {
+	dates := make(map[Date]time.Time, len(m.selectedDates)+1)
+	for k, v := range m.selectedDates {
+		dates[k] = v
+	}
+	if selected {
+		dates[m.dateOf(t)] = t
+	} else {
+		delete(dates, m.dateOf(t))
+	}
+	m.selectedDates = dates
+	m.Selected = len(dates) > 0
+}
+
+// compareDates compares the dates of a and b in the display location, ignoring
+// the time of day. The result is -1 if a is before b, 0 if they are the same
+// date and +1 otherwise.
+func (m Model) compareDates(a, b time.Time) int {
+	return m.dateOf(a).Compare(m.dateOf(b))
+}
+
+// sameDay reports whether a and b fall on the same calendar date
+func sameDay(a, b time.Time) bool {
+	return compareDays(a, b) == 0
+}
+
+// compareDays compares the calendar dates of a and b, ignoring the time of day.
+// The result is -1 if a is before b, 0 if they are the same date and +1 otherwise.
+func compareDays(a, b time.Time) int {
+	return DateOf(a).Compare(DateOf(b))
+}
+
+func sign(n int) int {
+	switch {
+	case n < 0:
+		return -1
+	case n > 0:
+		return 1
+	}
+	return 0
+}
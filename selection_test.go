@@ -0,0 +1,194 @@
+package datepicker
+
+import (
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func TestSelectDateRange(t *testing.T) {
+	model := New(halloween)
+	model.SelectionMode = SelectionRange
+
+	model.SelectDate()
+	if model.RangeStart != halloween || !model.RangeEnd.IsZero() || model.Selected {
+		t.Fatalf("TestSelectDateRange failure - expected range to be anchored at '%s'", halloween)
+	}
+
+	model.SetTime(thanksgiving)
+	model.SelectDate()
+	if model.RangeStart != halloween || model.RangeEnd != thanksgiving || !model.Selected {
+		t.Fatalf("TestSelectDateRange failure - want: '%s'-'%s' got: '%s'-'%s'", halloween, thanksgiving, model.RangeStart, model.RangeEnd)
+	}
+
+	// selecting again starts a new range
+	model.SetTime(xmas)
+	model.SelectDate()
+	if model.RangeStart != xmas || !model.RangeEnd.IsZero() || model.Selected {
+		t.Fatalf("TestSelectDateRange failure - expected range to be re-anchored at '%s'", xmas)
+	}
+
+	model.UnselectDate()
+	if !model.RangeStart.IsZero() || !model.RangeEnd.IsZero() {
+		t.Fatalf("TestSelectDateRange failure - expected `UnselectDate` to clear the range")
+	}
+}
+
+func TestSetRange(t *testing.T) {
+	tests := []struct {
+		start, end         time.Time
+		wantStart, wantEnd time.Time
+	}{
+		{start: halloween, end: xmas, wantStart: halloween, wantEnd: xmas},
+		{start: xmas, end: halloween, wantStart: halloween, wantEnd: xmas},
+		{start: thanksgiving, end: thanksgiving, wantStart: thanksgiving, wantEnd: thanksgiving},
+	}
+	model := New(halloween)
+	for i, test := range tests {
+		model.SetRange(test.start, test.end)
+		if model.RangeStart != test.wantStart || model.RangeEnd != test.wantEnd {
+			t.Errorf("TestSetRange failure - index: %d - want: '%s'-'%s' got: '%s'-'%s'", i, test.wantStart, test.wantEnd, model.RangeStart, model.RangeEnd)
+		}
+	}
+}
+
+func TestInRange(t *testing.T) {
+	tests := []struct {
+		input time.Time
+		want  bool
+	}{
+		{input: halloween.AddDate(0, 0, -1), want: false},
+		{input: halloween, want: true},
+		{input: thanksgiving.Add(13 * time.Hour), want: true},
+		{input: xmas, want: true},
+		{input: xmas.AddDate(0, 0, 1), want: false},
+	}
+	model := New(halloween)
+	model.SelectionMode = SelectionRange
+	model.SetRange(halloween, xmas)
+	for i, test := range tests {
+		if got := model.InRange(test.input); test.want != got {
+			t.Errorf("TestInRange failure - index: %d - want: '%t' got: '%t'", i, test.want, got)
+		}
+	}
+
+	// an anchored range previews up to the cursor
+	model.ClearRange()
+	model.SetTime(thanksgiving)
+	model.SelectDate()
+	model.SetTime(halloween)
+	if !model.InRange(time.Date(2023, time.November, 10, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("TestInRange failure - expected anchored range to include dates up to the cursor")
+	}
+}
+
+func TestUpdateRangeSelectedMsg(t *testing.T) {
+	model := New(halloween)
+	model.SelectionMode = SelectionRange
+	enter := tea.KeyMsg{Type: tea.KeyEnter}
+
+	model, cmd := model.Update(enter)
+	if cmd != nil {
+		t.Fatalf("TestUpdateRangeSelectedMsg failure - expected no cmd when anchoring the range")
+	}
+
+	model.NextWeek()
+	model, cmd = model.Update(enter)
+	if cmd == nil {
+		t.Fatalf("TestUpdateRangeSelectedMsg failure - expected a cmd when completing the range")
+	}
+	want := RangeSelectedMsg{Start: halloween, End: halloween.AddDate(0, 0, 7), StartDate: DateOf(halloween), EndDate: DateOf(halloween.AddDate(0, 0, 7))}
+	if got, ok := cmd().(RangeSelectedMsg); !ok || got != want {
+		t.Errorf("TestUpdateRangeSelectedMsg failure - want: '%v' got: '%v'", want, got)
+	}
+}
+
+func TestToggleDateSingle(t *testing.T) {
+	tests := []struct {
+		selected bool
+		want     bool
+	}{
+		{selected: false, want: true},
+		{selected: true, want: false},
+	}
+	for i, test := range tests {
+		model := New(halloween)
+		model.Selected = test.selected
+		model.ToggleDate()
+		if got := model.Selected; test.want != got {
+			t.Errorf("TestToggleDateSingle failure - index: %d - want: '%t' got: '%t'", i, test.want, got)
+		}
+	}
+}
+
+func TestToggleDate(t *testing.T) {
+	model := New(halloween)
+	model.SelectionMode = SelectionMultiple
+
+	model.ToggleDate()
+	model.NextMonth()
+	model.LastYear()
+	model.SetTime(xmas)
+	model.ToggleDate()
+	model.SetTime(thanksgiving)
+	model.ToggleDate()
+	model.ToggleDate()
+
+	want := []time.Time{halloween, xmas}
+	got := model.SelectedDates()
+	if len(got) != len(want) {
+		t.Fatalf("TestToggleDate failure - want: '%v' got: '%v'", want, got)
+	}
+	for i := range want {
+		if want[i] != got[i] {
+			t.Errorf("TestToggleDate failure - index: %d - want: '%s' got: '%s'", i, want[i], got[i])
+		}
+	}
+	if !model.Selected {
+		t.Errorf("TestToggleDate failure - expected model to be Selected")
+	}
+}
+
+func TestSetSelectedDates(t *testing.T) {
+	tests := []struct {
+		input time.Time
+		want  bool
+	}{
+		{input: halloween, want: true},
+		{input: thanksgiving, want: false},
+		{input: xmas.Add(20 * time.Hour), want: true},
+	}
+	model := New(halloween)
+	model.SelectionMode = SelectionMultiple
+	model.SetSelectedDates(xmas, halloween)
+	for i, test := range tests {
+		if got := model.IsDateSelected(test.input); test.want != got {
+			t.Errorf("TestSetSelectedDates failure - index: %d - want: '%t' got: '%t'", i, test.want, got)
+		}
+	}
+
+	model.ClearSelectedDates()
+	if len(model.SelectedDates()) != 0 || model.Selected {
+		t.Errorf("TestSetSelectedDates failure - expected `ClearSelectedDates` to empty the selection")
+	}
+}
+
+func TestUpdateDateToggledMsg(t *testing.T) {
+	model := New(halloween)
+	model.SelectionMode = SelectionMultiple
+	space := tea.KeyMsg{Type: tea.KeySpace, Runes: []rune{' '}}
+
+	prev := model
+	model, cmd := model.Update(space)
+	if prev.IsDateSelected(halloween) {
+		t.Errorf("TestUpdateDateToggledMsg failure - expected previous model copy to be left untouched")
+	}
+	if cmd == nil {
+		t.Fatalf("TestUpdateDateToggledMsg failure - expected a cmd when toggling a date")
+	}
+	want := DateToggledMsg{Time: halloween, Date: DateOf(halloween), Selected: true}
+	if got, ok := cmd().(DateToggledMsg); !ok || got != want {
+		t.Errorf("TestUpdateDateToggledMsg failure - want: '%v' got: '%v'", want, got)
+	}
+}
@@ -0,0 +1,461 @@
+package datepicker
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/charmbracelet/bubbles/help"
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// TimeFocus is a value passed to `TimeModel.SetFocus` to indicate which field of
+// the time picker accepts key msgs.
+type TimeFocus int
+
+const (
+	// TimeFocusNone is a value passed to `TimeModel.SetFocus` to ignore all time altering key msgs
+	TimeFocusNone TimeFocus = iota
+	// TimeFocusHour is a value passed to `TimeModel.SetFocus` to accept key msgs that change the hour
+	TimeFocusHour
+	// TimeFocusMinute is a value passed to `TimeModel.SetFocus` to accept key msgs that change the minute
+	TimeFocusMinute
+	// TimeFocusSecond is a value passed to `TimeModel.SetFocus` to accept key msgs that change the second
+	TimeFocusSecond
+	// TimeFocusPeriod is a value passed to `TimeModel.SetFocus` to accept key msgs that toggle AM and PM
+	TimeFocusPeriod
+)
+
+// Clock is a value assigned to `TimeModel.Clock` to indicate how hours are shown.
+type Clock int
+
+const (
+	// Clock24 shows hours from 00 to 23
+	Clock24 Clock = iota
+	// Clock12 shows hours from 12 to 11 followed by AM or PM
+	Clock12
+)
+
+// TimeKeyMap is the key bindings for different actions within the time picker.
+type TimeKeyMap struct {
+	Up        key.Binding
+	Right     key.Binding
+	Down      key.Binding
+	Left      key.Binding
+	FocusPrev key.Binding
+	FocusNext key.Binding
+	Toggle    key.Binding
+	Quit      key.Binding
+	Help      key.Binding
+}
+
+// DefaultTimeKeyMap returns a TimeKeyMap struct with default values
+func DefaultTimeKeyMap() TimeKeyMap {
+	return TimeKeyMap{
+		Up:        key.NewBinding(key.WithKeys("up", "k"), key.WithHelp("↑/k", "later")),
+		Right:     key.NewBinding(key.WithKeys("right", "l"), key.WithHelp("→/l", "next field")),
+		Down:      key.NewBinding(key.WithKeys("down", "j"), key.WithHelp("↓/j", "earlier")),
+		Left:      key.NewBinding(key.WithKeys("left", "h"), key.WithHelp("←/h", "previous field")),
+		FocusPrev: key.NewBinding(key.WithKeys("shift+tab"), key.WithHelp("shift+tab", "focus previous")),
+		FocusNext: key.NewBinding(key.WithKeys("tab"), key.WithHelp("tab", "focus next")),
+		Toggle:    key.NewBinding(key.WithKeys(" "), key.WithHelp("space", "am/pm")),
+		Quit:      key.NewBinding(key.WithKeys("ctrl+c", "q"), key.WithHelp("q", "close")),
+		Help:      key.NewBinding(key.WithKeys("?"), key.WithHelp("?", "more")),
+	}
+}
+
+// TimeStyles is a struct of lipgloss styles to apply to various elements of the time picker
+type TimeStyles struct {
+	Time lipgloss.Style
+
+	Text        lipgloss.Style
+	FocusedText lipgloss.Style
+	Separator   lipgloss.Style
+}
+
+// DefaultTimeStyles returns a default `TimeStyles` struct
+func DefaultTimeStyles() TimeStyles {
+	r := lipgloss.DefaultRenderer()
+	return TimeStyles{
+		Time:        r.NewStyle().Padding(1, 1),
+		Text:        r.NewStyle().Foreground(lipgloss.Color("247")),
+		FocusedText: r.NewStyle().Foreground(lipgloss.Color("212")).Bold(true),
+		Separator:   r.NewStyle().Foreground(lipgloss.Color("241")),
+	}
+}
+
+// TimeChangedMsg is sent by `TimeModel.Update` when the end user changes the
+// time of day.
+type TimeChangedMsg struct {
+	Old time.Time
+	New time.Time
+}
+
+// TimeModel is a struct that contains the state of the time picker component
+// and satisfies the `tea.Model` interface. It picks the hour, minute and
+// optionally second of its `Time`, leaving the date alone.
+type TimeModel struct {
+	// Time is the `time.Time` struct whose time of day is picked
+	Time time.Time
+
+	// KeyMap encodes the keybindings recognized by the model
+	KeyMap TimeKeyMap
+
+	// Styles represent the TimeStyles struct used to render the time picker
+	Styles TimeStyles
+
+	// Focused indicates the field which the end user is focused on
+	Focused TimeFocus
+
+	// Clock indicates whether hours are shown on a 24 hour or a 12 hour clock
+	Clock Clock
+
+	// ShowSeconds shows a field for the seconds after the minutes
+	ShowSeconds bool
+
+	// MinuteStep is the number of minutes the minute field moves by. Moving
+	// from a minute between steps lands on the nearest step. Values below 2
+	// move by a single minute.
+	MinuteStep int
+
+	// Help renders the help line below the time when `ShowHelp` is set
+	Help help.Model
+
+	// ShowHelp renders a help line for the bindings available to the current focus
+	ShowHelp bool
+
+	// QuitOnClose makes `KeyMap.Quit` quit the bubbletea program instead of
+	// sending a `CloseMsg`
+	QuitOnClose bool
+
+	// offsetX and offsetY are where the time picker is drawn on the screen
+	offsetX, offsetY int
+}
+
+// NewTime returns the TimeModel of the time picker
+func NewTime(t time.Time) TimeModel {
+	return TimeModel{
+		Time:    t,
+		KeyMap:  DefaultTimeKeyMap(),
+		Styles:  DefaultTimeStyles(),
+		Help:    help.New(),
+		Focused: TimeFocusHour,
+	}
+}
+
+// Init satisfies the `tea.Model` interface. This sends a nil cmd
+func (m TimeModel) Init() tea.Cmd {
+	return nil
+}
+
+// Update changes the state of the time picker. Update satisfies the `tea.Model` interface
+func (m TimeModel) Update(msg tea.Msg) (TimeModel, tea.Cmd) {
+	prev := m
+
+	switch msg := msg.(type) {
+	case tea.MouseMsg:
+		m.updateMouse(msg)
+
+	case ZoneSelectedMsg:
+		m.SetZone(msg.New)
+
+	case tea.KeyMsg:
+		switch {
+		case key.Matches(msg, m.KeyMap.Quit):
+			if m.QuitOnClose {
+				return m, tea.Quit
+			}
+			return m, func() tea.Msg { return CloseMsg{} }
+
+		case key.Matches(msg, m.KeyMap.Help):
+			m.Help.ShowAll = !m.Help.ShowAll
+
+		case m.Focused == TimeFocusNone:
+			// do nothing
+
+		case key.Matches(msg, m.KeyMap.Up):
+			m.stepField(m.Focused, 1)
+
+		case key.Matches(msg, m.KeyMap.Down):
+			m.stepField(m.Focused, -1)
+
+		case key.Matches(msg, m.KeyMap.Left), key.Matches(msg, m.KeyMap.FocusPrev):
+			m.moveFocus(-1)
+
+		case key.Matches(msg, m.KeyMap.Right), key.Matches(msg, m.KeyMap.FocusNext):
+			m.moveFocus(1)
+
+		case key.Matches(msg, m.KeyMap.Toggle):
+			if m.Clock == Clock12 {
+				m.TogglePeriod()
+			}
+		}
+	}
+
+	if m.Time.Equal(prev.Time) {
+		return m, nil
+	}
+	changed := TimeChangedMsg{Old: prev.Time, New: m.Time}
+	return m, func() tea.Msg { return changed }
+}
+
+// SetFocus focuses one of the fields of the time picker. This can also be used
+// to blur the time picker by passing the TimeFocus `TimeFocusNone`.
+func (m *TimeModel) SetFocus(f TimeFocus) {
+	m.Focused = f
+}
+
+// Blur sets the time picker focus to `TimeFocusNone`
+func (m *TimeModel) Blur() {
+	m.Focused = TimeFocusNone
+}
+
+// SetTime sets the model's `Time` struct
+func (m *TimeModel) SetTime(t time.Time) {
+	m.Time = t
+}
+
+// SetZone moves `Time` into loc, keeping the instant it represents, so that the
+// time of day is shown in loc
+func (m *TimeModel) SetZone(loc *time.Location) {
+	m.Time = m.Time.In(loc)
+}
+
+// SetOffset tells the time picker where the top left corner of its view is
+// drawn on the screen, so that the coordinates of a `tea.MouseMsg` can be
+// mapped onto it
+func (m *TimeModel) SetOffset(x, y int) {
+	m.offsetX = x
+	m.offsetY = y
+}
+
+// NextHour moves the model's `Time` forward 1 hour, wrapping around within the
+// day. On a 12 hour clock it wraps around within AM or PM.
+func (m *TimeModel) NextHour() {
+	m.stepField(TimeFocusHour, 1)
+}
+
+// LastHour moves the model's `Time` back 1 hour, wrapping around within the
+// day. On a 12 hour clock it wraps around within AM or PM.
+func (m *TimeModel) LastHour() {
+	m.stepField(TimeFocusHour, -1)
+}
+
+// NextMinute moves the model's `Time` forward to the next `MinuteStep`,
+// wrapping around within the hour
+func (m *TimeModel) NextMinute() {
+	m.stepField(TimeFocusMinute, 1)
+}
+
+// LastMinute moves the model's `Time` back to the previous `MinuteStep`,
+// wrapping around within the hour
+func (m *TimeModel) LastMinute() {
+	m.stepField(TimeFocusMinute, -1)
+}
+
+// NextSecond moves the model's `Time` forward 1 second, wrapping around within
+// the minute
+func (m *TimeModel) NextSecond() {
+	m.stepField(TimeFocusSecond, 1)
+}
+
+// LastSecond moves the model's `Time` back 1 second, wrapping around within the
+// minute
+func (m *TimeModel) LastSecond() {
+	m.stepField(TimeFocusSecond, -1)
+}
+
+// TogglePeriod moves the model's `Time` 12 hours between AM and PM
+func (m *TimeModel) TogglePeriod() {
+	m.setClock((m.Time.Hour()+12)%24, m.Time.Minute(), m.Time.Second(), false)
+}
+
+// stepField moves the field f forward or back by n steps
+func (m *TimeModel) stepField(f TimeFocus, n int) {
+	h, min, s := m.Time.Clock()
+	switch f {
+	case TimeFocusHour:
+		if m.Clock == Clock12 {
+			h = h/12*12 + wrap(h%12+n, 12)
+		} else {
+			h = wrap(h+n, 24)
+		}
+	case TimeFocusMinute:
+		min = stepWrap(min, n, m.minuteStep(), 60)
+	case TimeFocusSecond:
+		s = wrap(s+n, 60)
+	case TimeFocusPeriod:
+		h = (h + 12) % 24
+	default:
+		return
+	}
+	m.setClock(h, min, s, n < 0)
+}
+
+// setClock sets the time of day of the model's `Time`, keeping its date and
+// location. Sub-second precision is dropped. A time of day repeated by a
+// daylight saving change keeps the UTC offset of `Time`, and one skipped by a
+// change moves past the gap, or before it when back is set, so that stepping
+// through the day never gets stuck.
+func (m *TimeModel) setClock(hour, min, sec int, back bool) {
+	t := m.Time
+	_, offset := t.Zone()
+	wall := time.Date(t.Year(), t.Month(), t.Day(), hour, min, sec, 0, time.UTC)
+	m.Time = wallTime(wall, t.Location(), offset, back)
+}
+
+// minuteStep returns the model's `MinuteStep`, falling back to 1 minute
+func (m TimeModel) minuteStep() int {
+	if m.MinuteStep < 2 || m.MinuteStep > 60 {
+		return 1
+	}
+	return m.MinuteStep
+}
+
+// fields returns the fields shown by the time picker in order
+func (m TimeModel) fields() []TimeFocus {
+	fields := []TimeFocus{TimeFocusHour, TimeFocusMinute}
+	if m.ShowSeconds {
+		fields = append(fields, TimeFocusSecond)
+	}
+	if m.Clock == Clock12 {
+		fields = append(fields, TimeFocusPeriod)
+	}
+	return fields
+}
+
+// moveFocus focuses the field n places after the focused field, stopping at
+// the first and last fields
+func (m *TimeModel) moveFocus(n int) {
+	fields := m.fields()
+	for i, f := range fields {
+		if f != m.Focused {
+			continue
+		}
+		if j := i + n; j >= 0 && j < len(fields) {
+			m.Focused = fields[j]
+		}
+		return
+	}
+	m.Focused = fields[0]
+}
+
+// updateMouse focuses the field that is clicked, and steps the field under the
+// scroll wheel
+func (m *TimeModel) updateMouse(msg tea.MouseMsg) {
+	if m.Focused == TimeFocusNone {
+		return
+	}
+
+	layout := m.render()
+	t, ok := layout.hit(msg.X-m.offsetX, msg.Y-m.offsetY)
+	if !ok {
+		return
+	}
+	f := t.field()
+
+	switch msg.Type {
+	case tea.MouseWheelUp:
+		m.stepField(f, 1)
+	case tea.MouseWheelDown:
+		m.stepField(f, -1)
+	case tea.MouseLeft:
+		m.SetFocus(f)
+		if f == TimeFocusPeriod {
+			m.TogglePeriod()
+		}
+	}
+}
+
+// field returns the time picker field of a target
+func (t target) field() TimeFocus {
+	switch t.kind {
+	case targetHour:
+		return TimeFocusHour
+	case targetMinute:
+		return TimeFocusMinute
+	case targetSecond:
+		return TimeFocusSecond
+	case targetPeriod:
+		return TimeFocusPeriod
+	}
+	return TimeFocusNone
+}
+
+// View renders the time of day as a string in the bubbletea application. View
+// satisfies the `tea.Model` interface.
+func (m TimeModel) View() string {
+	return m.render().view
+}
+
+// render lays out the time of day along with the clickable regions used to map
+// mouse events onto it
+func (m TimeModel) render() block {
+	h, min, s := m.Time.Clock()
+
+	hour := fmt.Sprintf("%02d", h)
+	if m.Clock == Clock12 {
+		hour = fmt.Sprintf("%02d", wrap(h-1, 12)+1)
+	}
+
+	sep := plain(m.Styles.Separator.Render(":"))
+	row := []block{
+		m.renderField(TimeFocusHour, hour, targetHour),
+		sep,
+		m.renderField(TimeFocusMinute, fmt.Sprintf("%02d", min), targetMinute),
+	}
+	if m.ShowSeconds {
+		row = append(row, sep, m.renderField(TimeFocusSecond, fmt.Sprintf("%02d", s), targetSecond))
+	}
+	if m.Clock == Clock12 {
+		period := "AM"
+		if h >= 12 {
+			period = "PM"
+		}
+		row = append(row, plain(" "), m.renderField(TimeFocusPeriod, period, targetPeriod))
+	}
+
+	rows := []block{styled(m.Styles.Time, joinHorizontal(lipgloss.Top, row...))}
+	if m.ShowHelp {
+		rows = append(rows, plain(m.Help.View(m)))
+	}
+	return joinVertical(lipgloss.Center, rows...)
+}
+
+// renderField renders the text of a field, highlighted when it is focused
+func (m TimeModel) renderField(f TimeFocus, text string, kind targetKind) block {
+	style := m.Styles.Text
+	if m.Focused == f {
+		style = m.Styles.FocusedText
+	}
+	return clickable(style.Render(text), target{kind: kind})
+}
+
+// wrap returns n wrapped around into the range 0 to size-1
+func wrap(n, size int) int {
+	return ((n % size) + size) % size
+}
+
+// stepWrap moves v by n multiples of step, wrapping around within size. A v
+// between multiples moves to the nearest multiple in the direction of n first.
+func stepWrap(v, n, step, size int) int {
+	last := (size - 1) / step * step
+	for ; n > 0; n-- {
+		v = v/step*step + step
+		if v > last {
+			v = 0
+		}
+	}
+	for ; n < 0; n++ {
+		if v%step != 0 {
+			v -= v % step
+			continue
+		}
+		v -= step
+		if v < 0 {
+			v = last
+		}
+	}
+	return v
+}
@@ -0,0 +1,183 @@
+package datepicker
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+var teatime = time.Date(2023, time.October, 31, 16, 7, 30, 0, time.UTC)
+
+func TestTimeNavigation(t *testing.T) {
+	tests := []struct {
+		name  string
+		setup func(*TimeModel)
+		move  func(*TimeModel)
+		input time.Time
+		want  time.Time
+	}{
+		{name: "NextHour", move: (*TimeModel).NextHour, input: teatime, want: teatime.Add(time.Hour)},
+		{name: "NextHour wraps", move: (*TimeModel).NextHour, input: time.Date(2023, time.October, 31, 23, 0, 0, 0, time.UTC), want: time.Date(2023, time.October, 31, 0, 0, 0, 0, time.UTC)},
+		{name: "LastHour wraps", move: (*TimeModel).LastHour, input: time.Date(2023, time.October, 31, 0, 15, 0, 0, time.UTC), want: time.Date(2023, time.October, 31, 23, 15, 0, 0, time.UTC)},
+		{name: "NextHour 12h", setup: func(m *TimeModel) { m.Clock = Clock12 }, move: (*TimeModel).NextHour, input: time.Date(2023, time.October, 31, 11, 0, 0, 0, time.UTC), want: time.Date(2023, time.October, 31, 0, 0, 0, 0, time.UTC)},
+		{name: "LastHour 12h", setup: func(m *TimeModel) { m.Clock = Clock12 }, move: (*TimeModel).LastHour, input: time.Date(2023, time.October, 31, 12, 0, 0, 0, time.UTC), want: time.Date(2023, time.October, 31, 23, 0, 0, 0, time.UTC)},
+		{name: "NextMinute", move: (*TimeModel).NextMinute, input: teatime, want: teatime.Add(time.Minute)},
+		{name: "NextMinute wraps", move: (*TimeModel).NextMinute, input: time.Date(2023, time.October, 31, 23, 59, 0, 0, time.UTC), want: time.Date(2023, time.October, 31, 23, 0, 0, 0, time.UTC)},
+		{name: "NextMinute step", setup: func(m *TimeModel) { m.MinuteStep = 15 }, move: (*TimeModel).NextMinute, input: teatime, want: time.Date(2023, time.October, 31, 16, 15, 30, 0, time.UTC)},
+		{name: "LastMinute step", setup: func(m *TimeModel) { m.MinuteStep = 15 }, move: (*TimeModel).LastMinute, input: teatime, want: time.Date(2023, time.October, 31, 16, 0, 30, 0, time.UTC)},
+		{name: "LastMinute step wraps", setup: func(m *TimeModel) { m.MinuteStep = 15 }, move: (*TimeModel).LastMinute, input: time.Date(2023, time.October, 31, 16, 0, 0, 0, time.UTC), want: time.Date(2023, time.October, 31, 16, 45, 0, 0, time.UTC)},
+		{name: "NextMinute step wraps", setup: func(m *TimeModel) { m.MinuteStep = 25 }, move: (*TimeModel).NextMinute, input: time.Date(2023, time.October, 31, 16, 50, 0, 0, time.UTC), want: time.Date(2023, time.October, 31, 16, 0, 0, 0, time.UTC)},
+		{name: "NextSecond", move: (*TimeModel).NextSecond, input: teatime, want: teatime.Add(time.Second)},
+		{name: "LastSecond wraps", move: (*TimeModel).LastSecond, input: time.Date(2023, time.October, 31, 16, 7, 0, 0, time.UTC), want: time.Date(2023, time.October, 31, 16, 7, 59, 0, time.UTC)},
+		{name: "TogglePeriod", move: (*TimeModel).TogglePeriod, input: teatime, want: teatime.Add(-12 * time.Hour)},
+	}
+	for _, test := range tests {
+		model := NewTime(test.input)
+		if test.setup != nil {
+			test.setup(&model)
+		}
+		test.move(&model)
+		if model.Time != test.want {
+			t.Errorf("TestTimeNavigation failure - %s - want: '%s' got: '%s'", test.name, test.want, model.Time)
+		}
+	}
+}
+
+func TestTimeDaylightSaving(t *testing.T) {
+	ny := loadLocation(t, "America/New_York")
+	utc := func(month time.Month, day, hour, min int) time.Time {
+		return time.Date(2023, month, day, hour, min, 0, 0, time.UTC)
+	}
+
+	tests := []struct {
+		name  string
+		moves []func(*TimeModel)
+		input time.Time
+		want  time.Time
+	}{
+		// 02:00 to 02:59 is skipped on March 12
+		{name: "NextHour skips the gap", moves: []func(*TimeModel){(*TimeModel).NextHour}, input: utc(time.March, 12, 6, 0), want: utc(time.March, 12, 7, 0)},
+		{name: "NextHour past the gap", moves: []func(*TimeModel){(*TimeModel).NextHour, (*TimeModel).NextHour}, input: utc(time.March, 12, 6, 0), want: utc(time.March, 12, 8, 0)},
+		{name: "LastHour skips the gap", moves: []func(*TimeModel){(*TimeModel).LastHour}, input: utc(time.March, 12, 7, 0), want: utc(time.March, 12, 6, 0)},
+		{name: "TogglePeriod into the gap", moves: []func(*TimeModel){(*TimeModel).TogglePeriod}, input: utc(time.March, 12, 18, 0), want: utc(time.March, 12, 7, 0)},
+		// 01:00 to 01:59 is repeated on November 5
+		{name: "NextMinute keeps EST", moves: []func(*TimeModel){(*TimeModel).NextMinute}, input: utc(time.November, 5, 6, 30), want: utc(time.November, 5, 6, 31)},
+		{name: "NextMinute keeps EDT", moves: []func(*TimeModel){(*TimeModel).NextMinute}, input: utc(time.November, 5, 5, 30), want: utc(time.November, 5, 5, 31)},
+		{name: "LastHour into the repeat", moves: []func(*TimeModel){(*TimeModel).LastHour}, input: utc(time.November, 5, 7, 30), want: utc(time.November, 5, 6, 30)},
+	}
+	for _, test := range tests {
+		model := NewTime(test.input.In(ny))
+		for _, move := range test.moves {
+			move(&model)
+		}
+		if !model.Time.Equal(test.want) || model.Time.Location() != ny {
+			t.Errorf("TestTimeDaylightSaving failure - %s - want: '%s' got: '%s'", test.name, test.want.In(ny), model.Time)
+		}
+	}
+}
+
+func TestTimeUpdateKeys(t *testing.T) {
+	model := NewTime(teatime)
+	model.Clock = Clock12
+	model.ShowSeconds = true
+
+	keys := []struct {
+		msg   tea.KeyMsg
+		focus TimeFocus
+		want  time.Time
+	}{
+		{msg: tea.KeyMsg{Type: tea.KeyUp}, focus: TimeFocusHour, want: time.Date(2023, time.October, 31, 17, 7, 30, 0, time.UTC)},
+		{msg: tea.KeyMsg{Type: tea.KeyRight}, focus: TimeFocusMinute, want: time.Date(2023, time.October, 31, 17, 7, 30, 0, time.UTC)},
+		{msg: tea.KeyMsg{Type: tea.KeyDown}, focus: TimeFocusMinute, want: time.Date(2023, time.October, 31, 17, 6, 30, 0, time.UTC)},
+		{msg: tea.KeyMsg{Type: tea.KeyTab}, focus: TimeFocusSecond, want: time.Date(2023, time.October, 31, 17, 6, 30, 0, time.UTC)},
+		{msg: tea.KeyMsg{Type: tea.KeyTab}, focus: TimeFocusPeriod, want: time.Date(2023, time.October, 31, 17, 6, 30, 0, time.UTC)},
+		{msg: tea.KeyMsg{Type: tea.KeyTab}, focus: TimeFocusPeriod, want: time.Date(2023, time.October, 31, 17, 6, 30, 0, time.UTC)},
+		{msg: tea.KeyMsg{Type: tea.KeySpace, Runes: []rune(" ")}, focus: TimeFocusPeriod, want: time.Date(2023, time.October, 31, 5, 6, 30, 0, time.UTC)},
+		{msg: tea.KeyMsg{Type: tea.KeyLeft}, focus: TimeFocusSecond, want: time.Date(2023, time.October, 31, 5, 6, 30, 0, time.UTC)},
+	}
+	for i, k := range keys {
+		var cmd tea.Cmd
+		before := model.Time
+		model, cmd = model.Update(k.msg)
+		if model.Focused != k.focus || model.Time != k.want {
+			t.Fatalf("TestTimeUpdateKeys failure - index: %d - want: '%d' '%s' got: '%d' '%s'", i, k.focus, k.want, model.Focused, model.Time)
+		}
+		if before == model.Time {
+			if cmd != nil {
+				t.Errorf("TestTimeUpdateKeys failure - index: %d - expected no cmd", i)
+			}
+			continue
+		}
+		if msg, ok := cmd().(TimeChangedMsg); !ok || msg.Old != before || msg.New != model.Time {
+			t.Errorf("TestTimeUpdateKeys failure - index: %d - want: '%v' got: '%v'", i, TimeChangedMsg{Old: before, New: model.Time}, msg)
+		}
+	}
+
+	model.Blur()
+	if model, _ = model.Update(tea.KeyMsg{Type: tea.KeyUp}); model.Time.Hour() != 5 {
+		t.Errorf("TestTimeUpdateKeys failure - expected a blurred time picker to ignore keys")
+	}
+}
+
+func TestTimeView(t *testing.T) {
+	tests := []struct {
+		setup func(*TimeModel)
+		input time.Time
+		want  string
+	}{
+		{input: teatime, want: "16:07"},
+		{setup: func(m *TimeModel) { m.ShowSeconds = true }, input: teatime, want: "16:07:30"},
+		{setup: func(m *TimeModel) { m.Clock = Clock12 }, input: teatime, want: "04:07 PM"},
+		{setup: func(m *TimeModel) { m.Clock = Clock12 }, input: time.Date(2023, time.October, 31, 0, 5, 0, 0, time.UTC), want: "12:05 AM"},
+		{setup: func(m *TimeModel) { m.Clock = Clock12 }, input: time.Date(2023, time.October, 31, 12, 5, 0, 0, time.UTC), want: "12:05 PM"},
+	}
+	for i, test := range tests {
+		model := NewTime(test.input)
+		if test.setup != nil {
+			test.setup(&model)
+		}
+		if view := model.View(); !strings.Contains(view, test.want) {
+			t.Errorf("TestTimeView failure - index: %d - want: '%s' got: '%s'", i, test.want, view)
+		}
+	}
+}
+
+func TestTimeMouse(t *testing.T) {
+	model := NewTime(teatime)
+	model.Clock = Clock12
+	model.SetOffset(3, 2)
+	view := model.View()
+
+	x, y, _ := findText(view, "07", 0)
+	model, _ = model.Update(click(x+3, y+2))
+	if model.Focused != TimeFocusMinute {
+		t.Errorf("TestTimeMouse failure - want: '%d' got: '%d'", TimeFocusMinute, model.Focused)
+	}
+
+	model, _ = model.Update(tea.MouseMsg{X: x + 3, Y: y + 2, Type: tea.MouseWheelUp})
+	if model.Time.Minute() != 8 {
+		t.Errorf("TestTimeMouse failure - want minute: '%d' got: '%d'", 8, model.Time.Minute())
+	}
+
+	x, y, _ = findText(view, "PM", 0)
+	model, _ = model.Update(click(x+3, y+2))
+	if model.Focused != TimeFocusPeriod || model.Time.Hour() != 4 {
+		t.Errorf("TestTimeMouse failure - want: '%d' at hour %d got: '%d' at hour %d", TimeFocusPeriod, 4, model.Focused, model.Time.Hour())
+	}
+}
+
+func TestTimeHelp(t *testing.T) {
+	model := NewTime(teatime)
+	for _, b := range model.ShortHelp() {
+		if b.Help().Key == model.KeyMap.Left.Help().Key && b.Enabled() {
+			t.Errorf("TestTimeHelp failure - expected left to be hidden on the first field")
+		}
+	}
+
+	model.Blur()
+	if got := model.ShortHelp(); got != nil {
+		t.Errorf("TestTimeHelp failure - expected no help when blurred, got: '%v'", got)
+	}
+}
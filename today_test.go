@@ -0,0 +1,69 @@
+package datepicker
+
+import (
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+func clock(t time.Time) func() time.Time {
+	return func() time.Time { return t }
+}
+
+func TestToday(t *testing.T) {
+	tests := []struct {
+		input    time.Time
+		min, max time.Time
+		want     time.Time
+	}{
+		{input: halloween, want: thanksgiving},
+		{input: xmas.Add(9 * time.Hour), want: thanksgiving.Add(9 * time.Hour)},
+		{input: halloween, max: octFirst, want: octFirst},
+		{input: halloween, min: decFirst, want: decFirst},
+	}
+	for i, test := range tests {
+		model := New(test.input)
+		model.Now = clock(thanksgiving.Add(15 * time.Hour))
+		model.SetBounds(test.min, test.max)
+		model.Today()
+		if got := model.Time; test.want != got {
+			t.Errorf("TestToday failure - index: %d - want: '%s' got: '%s'", i, test.want, got)
+		}
+	}
+}
+
+func TestUpdateToday(t *testing.T) {
+	model := New(halloween)
+	model.Now = clock(xmas)
+
+	model, _ = model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'t'}})
+	if got := model.Time; got != xmas {
+		t.Errorf("TestUpdateToday failure - want: '%s' got: '%s'", xmas, got)
+	}
+}
+
+func TestDateTextStyleToday(t *testing.T) {
+	model := New(halloween)
+	model.Now = clock(halloween)
+	model.Styles.Text = lipgloss.NewStyle().Foreground(lipgloss.Color("247"))
+	model.Styles.TodayText = lipgloss.NewStyle().Foreground(lipgloss.Color("39")).Underline(true)
+	model.Styles.FocusedText = lipgloss.NewStyle().Foreground(lipgloss.Color("212")).Bold(true)
+
+	style := model.dateTextStyle(halloween)
+	if got := style.GetForeground(); got != lipgloss.Color("39") || !style.GetUnderline() {
+		t.Errorf("TestDateTextStyleToday failure - expected today to use `TodayText`, got foreground: '%v'", got)
+	}
+
+	model.SelectDate()
+	style = model.dateTextStyle(halloween)
+	if got := style.GetForeground(); got != lipgloss.Color("212") || !style.GetBold() || !style.GetUnderline() {
+		t.Errorf("TestDateTextStyleToday failure - expected focused today to combine `FocusedText` and `TodayText`, got foreground: '%v'", got)
+	}
+
+	style = model.dateTextStyle(thanksgiving)
+	if style.GetUnderline() {
+		t.Errorf("TestDateTextStyleToday failure - expected other dates to not use `TodayText`")
+	}
+}
@@ -0,0 +1,210 @@
+package datepicker
+
+import (
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"time"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+const (
+	// maxYearDigits is the number of digits that can be typed into the year header
+	maxYearDigits = 4
+	// maxDayDigits is the number of digits that can be typed into the calendar
+	maxDayDigits = 2
+)
+
+// lastTypeAheadID is the last id handed out to a digit typed into the calendar.
+// The ids are unique across models so that several datepickers in one program
+// ignore each other's timeouts.
+var lastTypeAheadID int64
+
+func nextTypeAheadID() int {
+	return int(atomic.AddInt64(&lastTypeAheadID, 1))
+}
+
+// typeAheadTimeoutMsg ends the day number typed into the calendar when no other
+// digit followed it in time
+type typeAheadTimeoutMsg struct {
+	id int
+}
+
+// updateTyping handles keys typed into the calendar or the focused month or
+// year header, reporting whether the key was used. Digits typed into the
+// calendar jump to that day of the month. Digits typed into the year header are kept
+// until they are committed with `KeyMap.Select` or dropped with `KeyMap.Cancel`.
+// Letters typed into the month header jump to the first month whose name starts
+// with them, and `KeyMap.Cancel` jumps back. A first letter bound to
+// `KeyMap.Up`, `KeyMap.Right`, `KeyMap.Down` or `KeyMap.Left` moves, and only
+// starts a month name when the next letter continues one, so that "j" moves
+// down while "jun" jumps to June. Keys that are not typed end the typing,
+// keeping the month jumped to.
+func (m *Model) updateTyping(msg tea.KeyMsg) (bool, tea.Cmd) {
+	typedRune := msg.Type == tea.KeyRunes && len(msg.Runes) == 1
+	if m.typedMove {
+		m.typedMove = false
+		if _, ok := m.locale().monthByPrefix(m.typed + string(msg.Runes)); !typedRune || !ok {
+			m.typed = ""
+		}
+	}
+
+	if m.ViewMode != ViewMonth || m.Focused == FocusNone {
+		return false, nil
+	}
+
+	switch {
+	case m.Focused == FocusCalendar:
+		if typedRune && unicode.IsDigit(msg.Runes[0]) {
+			return true, m.typeDay(msg.Runes[0])
+		}
+	case m.typed != "" && key.Matches(msg, m.KeyMap.Select):
+		m.commitTyped()
+		return true, nil
+	case m.typed != "" && key.Matches(msg, m.KeyMap.Cancel):
+		m.revertTyped()
+		return true, nil
+	case m.typed != "" && msg.Type == tea.KeyBackspace:
+		_, size := utf8.DecodeLastRuneInString(m.typed)
+		m.setTyped(m.typed[:len(m.typed)-size])
+		return true, nil
+	case typedRune && m.typed == "" && m.Focused == FocusHeaderMonth && key.Matches(msg, m.KeyMap.Up, m.KeyMap.Right, m.KeyMap.Down, m.KeyMap.Left):
+		// the letter moves, but is kept in case the next letter continues a month name
+		if _, ok := m.locale().monthByPrefix(string(msg.Runes)); ok {
+			m.typed = string(msg.Runes)
+			m.typedOrigin = m.Time
+			m.typedMove = true
+		}
+		return false, nil
+	case typedRune:
+		if m.typeRune(msg.Runes[0]) {
+			return true, nil
+		}
+	}
+
+	m.typed = ""
+	return false, nil
+}
+
+// typeDay adds the digit r to the day number typed into the calendar and moves
+// the cursor to that day of the cursor's month. Digits that would make a day
+// the month does not have are ignored, and a digit typed after a full day
+// number starts a new one. The returned cmd ends the day number after
+// `TypeAheadTimeout`.
+func (m *Model) typeDay(r rune) tea.Cmd {
+	text := m.typed + string(r)
+	if len(m.typed) >= maxDayDigits {
+		text = string(r)
+	}
+	day, _ := strconv.Atoi(text)
+	c := m.cursor()
+	if day < 1 || day > daysIn(c.Year(), c.Month()) {
+		return nil
+	}
+
+	m.typed = text
+	t := onDate(c.Year(), c.Month(), day, c)
+	if m.IsSelectable(t) {
+		m.setCursor(t)
+	}
+
+	m.typedID = nextTypeAheadID()
+	id := m.typedID
+	return tea.Tick(m.typeAheadTimeout(), func(time.Time) tea.Msg {
+		return typeAheadTimeoutMsg{id: id}
+	})
+}
+
+// typeAheadTimeout returns `TypeAheadTimeout`, or 1 second when it is unset
+func (m Model) typeAheadTimeout() time.Duration {
+	if m.TypeAheadTimeout <= 0 {
+		return time.Second
+	}
+	return m.TypeAheadTimeout
+}
+
+// typeRune adds r to the typed text when it is a digit of a year, or continues
+// the name of a month. It reports whether r was used. Digits past the length
+// of a year are used but ignored.
+func (m *Model) typeRune(r rune) bool {
+	text := m.typed + string(r)
+	switch m.Focused {
+	case FocusHeaderYear:
+		if !unicode.IsDigit(r) {
+			return false
+		}
+		if len(m.typed) >= maxYearDigits {
+			return true
+		}
+	case FocusHeaderMonth:
+		if _, ok := m.locale().monthByPrefix(text); !ok {
+			return false
+		}
+	}
+	if m.typed == "" {
+		m.typedOrigin = m.Time
+	}
+	m.setTyped(text)
+	return true
+}
+
+// setTyped replaces the typed text, moving the cursor to the month it names in
+// the month header. Clearing the typed text of the month header jumps back to
+// where the typing started.
+func (m *Model) setTyped(text string) {
+	m.typed = text
+	if m.Focused != FocusHeaderMonth {
+		return
+	}
+	m.Time = m.typedOrigin
+	if text == "" {
+		return
+	}
+	if month, ok := m.locale().monthByPrefix(text); ok {
+		m.moveToMonth(m.cursor().Year(), month)
+	}
+}
+
+// commitTyped moves the cursor to the typed year. Years outside of
+// `MinDate` and `MaxDate` are refused and left to be corrected.
+func (m *Model) commitTyped() {
+	if m.Focused == FocusHeaderYear {
+		year, ok := m.typedYear()
+		if !ok {
+			return
+		}
+		m.moveToMonth(year, m.cursor().Month())
+	}
+	m.typed = ""
+}
+
+// revertTyped drops the typed text, moving the cursor back to where the typing started
+func (m *Model) revertTyped() {
+	m.Time = m.typedOrigin
+	m.typed = ""
+}
+
+// typedYear returns the year typed into the year header and whether it can be
+// moved to. Only a full year of `maxYearDigits` digits can be moved to, so that
+// "19" is not read as the year 19.
+func (m Model) typedYear() (int, bool) {
+	year, err := strconv.Atoi(m.typed)
+	if err != nil || len(m.typed) < maxYearDigits || year < 1 {
+		return 0, false
+	}
+	return year, m.yearInBounds(year)
+}
+
+// typedYearText returns the year being typed into the year header, padded to
+// show how many digits may follow
+func (m Model) typedYearText() string {
+	text := m.typed + strings.Repeat("_", maxYearDigits-len(m.typed))
+	if _, ok := m.typedYear(); !ok {
+		return m.Styles.OutOfBoundsText.Render(text)
+	}
+	return m.Styles.FocusedText.Render(text)
+}
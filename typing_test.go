@@ -0,0 +1,158 @@
+package datepicker
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// typeKeys returns the key msgs for typing text followed by the given keys
+func typeKeys(text string, keys ...tea.KeyType) []tea.KeyMsg {
+	msgs := []tea.KeyMsg{}
+	for _, r := range text {
+		msgs = append(msgs, tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{r}})
+	}
+	for _, k := range keys {
+		msgs = append(msgs, tea.KeyMsg{Type: k})
+	}
+	return msgs
+}
+
+func TestTypeYear(t *testing.T) {
+	tests := []struct {
+		input []tea.KeyMsg
+		want  time.Time
+		typed string
+	}{
+		{input: typeKeys("1987", tea.KeyEnter), want: time.Date(1987, time.October, 31, 0, 0, 0, 0, time.UTC), typed: ""},
+		{input: typeKeys("19875", tea.KeyEnter), want: time.Date(1987, time.October, 31, 0, 0, 0, 0, time.UTC), typed: ""},
+		{input: typeKeys("1988", tea.KeyBackspace, tea.KeyBackspace), want: halloween, typed: "19"},
+		{input: typeKeys("198", tea.KeyBackspace, tea.KeyEnter), want: halloween, typed: "19"},
+		{input: typeKeys("0019", tea.KeyEnter), want: time.Date(19, time.October, 31, 0, 0, 0, 0, time.UTC), typed: ""},
+		{input: typeKeys("0000", tea.KeyEnter), want: halloween, typed: "0000"},
+		{input: typeKeys("1987", tea.KeyEsc), want: halloween, typed: ""},
+		{input: typeKeys("0", tea.KeyEnter), want: halloween, typed: "0"},
+		{input: typeKeys("1987", tea.KeyUp), want: halloween.AddDate(-1, 0, 0), typed: ""},
+		{input: typeKeys("ab"), want: halloween, typed: ""},
+	}
+	for i, test := range tests {
+		model := New(halloween)
+		model.SetFocus(FocusHeaderYear)
+		for _, msg := range test.input {
+			model, _ = model.Update(msg)
+		}
+		if model.Time != test.want || model.typed != test.typed {
+			t.Errorf("TestTypeYear failure - index: %d - want: '%s' '%s' got: '%s' '%s'", i, test.want, test.typed, model.Time, model.typed)
+		}
+	}
+}
+
+func TestTypeYearBounds(t *testing.T) {
+	model := New(halloween)
+	model.SetBounds(time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC), time.Time{})
+	model.SetFocus(FocusHeaderYear)
+	for _, msg := range typeKeys("1987", tea.KeyEnter) {
+		model, _ = model.Update(msg)
+	}
+	if model.Time != halloween || model.typed != "1987" {
+		t.Errorf("TestTypeYearBounds failure - expected '1987' to be refused, got: '%s' '%s'", model.Time, model.typed)
+	}
+	if !strings.Contains(model.View(), "1987") {
+		t.Errorf("TestTypeYearBounds failure - expected typed year in view")
+	}
+}
+
+func TestTypeMonth(t *testing.T) {
+	tests := []struct {
+		input []tea.KeyMsg
+		want  time.Time
+	}{
+		{input: typeKeys("no"), want: time.Date(2023, time.November, 30, 0, 0, 0, 0, time.UTC)},
+		{input: typeKeys("ma"), want: time.Date(2023, time.March, 31, 0, 0, 0, 0, time.UTC)},
+		{input: typeKeys("MAY"), want: time.Date(2023, time.May, 31, 0, 0, 0, 0, time.UTC)},
+		{input: typeKeys("jun", tea.KeyBackspace), want: time.Date(2023, time.June, 30, 0, 0, 0, 0, time.UTC)},
+		{input: typeKeys("jul", tea.KeyBackspace, tea.KeyBackspace, tea.KeyBackspace), want: halloween},
+		{input: typeKeys("feb", tea.KeyEsc), want: halloween},
+		{input: typeKeys("feb", tea.KeyEnter), want: time.Date(2023, time.February, 28, 0, 0, 0, 0, time.UTC)},
+		{input: typeKeys("febx"), want: time.Date(2023, time.February, 28, 0, 0, 0, 0, time.UTC)},
+		{input: typeKeys("k"), want: time.Date(2023, time.September, 30, 0, 0, 0, 0, time.UTC)},
+		{input: typeKeys("j"), want: time.Date(2023, time.November, 30, 0, 0, 0, 0, time.UTC)},
+		{input: typeKeys("jj"), want: time.Date(2023, time.December, 31, 0, 0, 0, 0, time.UTC)},
+		{input: typeKeys("ja"), want: time.Date(2023, time.January, 31, 0, 0, 0, 0, time.UTC)},
+		{input: typeKeys("jun"), want: time.Date(2023, time.June, 30, 0, 0, 0, 0, time.UTC)},
+		{input: typeKeys("jul", tea.KeyEsc), want: halloween},
+		{input: typeKeys("j", tea.KeyEnter), want: time.Date(2023, time.November, 30, 0, 0, 0, 0, time.UTC)},
+	}
+	for i, test := range tests {
+		model := New(halloween)
+		model.SetFocus(FocusHeaderMonth)
+		for _, msg := range test.input {
+			model, _ = model.Update(msg)
+		}
+		if model.Time != test.want {
+			t.Errorf("TestTypeMonth failure - index: %d - want: '%s' got: '%s'", i, test.want, model.Time)
+		}
+	}
+}
+
+func TestTypeMonthLocale(t *testing.T) {
+	model := New(halloween)
+	model.SetLocale(LocaleGerman)
+	model.SetFocus(FocusHeaderMonth)
+	for _, msg := range typeKeys("mä") {
+		model, _ = model.Update(msg)
+	}
+	if model.Time.Month() != time.March {
+		t.Errorf("TestTypeMonthLocale failure - want: '%s' got: '%s'", time.March, model.Time.Month())
+	}
+}
+
+func TestTypeDay(t *testing.T) {
+	tests := []struct {
+		start time.Time
+		input string
+		want  int
+	}{
+		{start: halloween, input: "25", want: 25},
+		{start: halloween, input: "2", want: 2},
+		{start: halloween, input: "35", want: 3},
+		{start: halloween, input: "0", want: 31},
+		{start: halloween, input: "251", want: 1},
+		{start: time.Date(2023, time.February, 10, 0, 0, 0, 0, time.UTC), input: "29", want: 2},
+		{start: time.Date(2024, time.February, 10, 0, 0, 0, 0, time.UTC), input: "29", want: 29},
+	}
+	for i, test := range tests {
+		model := New(test.start)
+		for _, msg := range typeKeys(test.input) {
+			model, _ = model.Update(msg)
+		}
+		if got := model.Time.Day(); got != test.want || model.Time.Month() != test.start.Month() {
+			t.Errorf("TestTypeDay failure - index: %d - want: '%d' got: '%d'", i, test.want, got)
+		}
+	}
+}
+
+func TestTypeDayTimeout(t *testing.T) {
+	model := New(halloween)
+
+	model, cmd := model.Update(typeKeys("1")[0])
+	if cmd == nil {
+		t.Fatalf("TestTypeDayTimeout failure - expected a cmd for the timeout")
+	}
+	stale := typeAheadTimeoutMsg{id: model.typedID}
+	model, _ = model.Update(stale)
+	model, _ = model.Update(typeKeys("5")[0])
+	if model.Time.Day() != 5 {
+		t.Errorf("TestTypeDayTimeout failure - want: '%d' got: '%d'", 5, model.Time.Day())
+	}
+
+	model, _ = model.Update(typeAheadTimeoutMsg{id: model.typedID})
+	model, _ = model.Update(typeKeys("1")[0])
+	model, _ = model.Update(stale)
+	model, _ = model.Update(typeKeys("5")[0])
+	if model.Time.Day() != 15 {
+		t.Errorf("TestTypeDayTimeout failure - expected stale timeout to be ignored, want: '%d' got: '%d'", 15, model.Time.Day())
+	}
+}
@@ -0,0 +1,116 @@
+package datepicker
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// SetViewMode switches between the month view and the year overview. The
+// calendar is focused when switching so the cursor can be moved right away.
+func (m *Model) SetViewMode(v ViewMode) {
+	m.ViewMode = v
+	m.SetFocus(FocusCalendar)
+}
+
+// updateYearView handles key msgs while the year overview is shown. The arrow
+// keys move the cursor by day and week, and selecting zooms back into the month
+// of the cursor.
+func (m *Model) updateYearView(msg tea.KeyMsg) {
+	switch {
+	case key.Matches(msg, m.KeyMap.Up):
+		m.LastWeek()
+	case key.Matches(msg, m.KeyMap.Down):
+		m.NextWeek()
+	case key.Matches(msg, m.KeyMap.Left):
+		m.Yesterday()
+	case key.Matches(msg, m.KeyMap.Right):
+		m.Tomorrow()
+	case key.Matches(msg, m.KeyMap.Select), key.Matches(msg, m.KeyMap.YearView), key.Matches(msg, m.KeyMap.Cancel):
+		m.SetViewMode(ViewMonth)
+	}
+}
+
+// clickYearView handles a click on the year overview. Clicking a date or a
+// month zooms into it.
+func (m *Model) clickYearView(t target) {
+	switch t.kind {
+	case targetDate:
+		if !m.IsSelectable(t.date) {
+			return
+		}
+		m.setCursor(withDate(m.cursor(), t.date))
+		m.SetViewMode(ViewMonth)
+	case targetMonth:
+		m.moveToMonth(t.date.Year(), t.date.Month())
+		m.SetViewMode(ViewMonth)
+	case targetLastPage:
+		m.page(-1)
+	case targetNextPage:
+		m.page(1)
+	}
+}
+
+// yearViewColumns returns the number of months per row in the year overview
+func (m Model) yearViewColumns() int {
+	if m.YearViewColumns < 1 {
+		return 3
+	}
+	return m.YearViewColumns
+}
+
+// renderYear renders all twelve months of the cursor's year as compact calendars
+func (m Model) renderYear() block {
+	year := m.cursor().Year()
+
+	header := m.renderPageHeader(m.locale().FormatYear(year), m.yearInBounds(year-1), m.yearInBounds(year+1))
+
+	columns := m.yearViewColumns()
+	rows := []block{header}
+	for i := 0; i < 12; i += columns {
+		row := []block{}
+		for month := i + 1; month <= i+columns && month <= 12; month++ {
+			row = append(row, m.renderMiniMonth(m.firstOfMonth(year, time.Month(month))))
+		}
+		rows = append(rows, joinHorizontal(lipgloss.Top, row...))
+	}
+	return joinVertical(lipgloss.Center, rows...)
+}
+
+// renderMiniMonth renders a compact calendar of the month beginning on first
+func (m Model) renderMiniMonth(first time.Time) block {
+	locale := m.locale()
+	month := first.Month()
+
+	titleStyle := m.Styles.HeaderText
+	if month == m.cursor().Month() {
+		titleStyle = m.Styles.FocusedText
+	}
+	rows := []block{
+		clickable(titleStyle.Render(locale.MonthName(month)), target{kind: targetMonth, date: first}),
+	}
+
+	weekHeaders := []block{}
+	for _, wd := range weekdays(m.WeekStart) {
+		weekHeaders = append(weekHeaders, plain(m.Styles.YearDate.Copy().Inherit(m.Styles.HeaderText).Render(locale.WeekdayName(wd))))
+	}
+	rows = append(rows, joinHorizontal(lipgloss.Top, weekHeaders...))
+
+	for _, week := range monthGrid(first.Year(), month, m.WeekStart, m.location()) {
+		row := []block{}
+		for _, day := range week {
+			if day.Month() != month {
+				row = append(row, plain(m.Styles.YearDate.Render("  ")))
+				continue
+			}
+			out := m.Styles.YearDate.Copy().Inherit(m.dateTextStyle(day)).Render(fmt.Sprintf("%02d", day.Day()))
+			row = append(row, clickable(out, target{kind: targetDate, date: day}))
+		}
+		rows = append(rows, joinHorizontal(lipgloss.Top, row...))
+	}
+
+	return styled(m.Styles.YearMonth, joinVertical(lipgloss.Left, rows...))
+}
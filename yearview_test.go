@@ -0,0 +1,101 @@
+package datepicker
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func TestUpdateYearView(t *testing.T) {
+	tests := []struct {
+		input tea.KeyMsg
+		want  time.Time
+		mode  ViewMode
+	}{
+		{input: tea.KeyMsg{Type: tea.KeyUp}, want: halloween.AddDate(0, 0, -7), mode: ViewYear},
+		{input: tea.KeyMsg{Type: tea.KeyDown}, want: halloween.AddDate(0, 0, 7), mode: ViewYear},
+		{input: tea.KeyMsg{Type: tea.KeyLeft}, want: halloween.AddDate(0, 0, -1), mode: ViewYear},
+		{input: tea.KeyMsg{Type: tea.KeyRight}, want: halloween.AddDate(0, 0, 1), mode: ViewYear},
+		{input: tea.KeyMsg{Type: tea.KeyTab}, want: halloween, mode: ViewYear},
+		{input: tea.KeyMsg{Type: tea.KeyEnter}, want: halloween, mode: ViewMonth},
+		{input: tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'y'}}, want: halloween, mode: ViewMonth},
+	}
+	for i, test := range tests {
+		model := New(halloween)
+		model, _ = model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'y'}})
+		if model.ViewMode != ViewYear {
+			t.Fatalf("TestUpdateYearView failure - index: %d - expected year view", i)
+		}
+
+		model, _ = model.Update(test.input)
+		if model.Time != test.want || model.ViewMode != test.mode {
+			t.Errorf("TestUpdateYearView failure - index: %d - want: '%s' %d got: '%s' %d", i, test.want, test.mode, model.Time, model.ViewMode)
+		}
+		if model.Focused != FocusCalendar {
+			t.Errorf("TestUpdateYearView failure - index: %d - want: '%s' got: '%s'", i, FocusCalendar, model.Focused)
+		}
+	}
+}
+
+// weekdayRows counts the lines of the view holding weekday headers
+func weekdayRows(view string) int {
+	rows := 0
+	for _, line := range strings.Split(view, "\n") {
+		if strings.Contains(line, "Su Mo Tu We Th Fr Sa") {
+			rows++
+		}
+	}
+	return rows
+}
+
+func TestRenderYear(t *testing.T) {
+	model := New(halloween)
+	model.SetViewMode(ViewYear)
+	view := model.View()
+
+	for month := time.January; month <= time.December; month++ {
+		if !strings.Contains(view, month.String()) {
+			t.Errorf("TestRenderYear failure - expected '%s' in view", month)
+		}
+	}
+	tests := []struct {
+		columns int
+		want    int
+	}{
+		{columns: 0, want: 4},
+		{columns: 3, want: 4},
+		{columns: 4, want: 3},
+	}
+	for i, test := range tests {
+		model.YearViewColumns = test.columns
+		if got := weekdayRows(model.View()); got != test.want {
+			t.Errorf("TestRenderYear failure - index: %d - want: '%d' got: '%d'", i, test.want, got)
+		}
+	}
+}
+
+func TestMouseYearView(t *testing.T) {
+	model := New(halloween)
+	model.SetViewMode(ViewYear)
+
+	x, y, _ := findText(model.View(), "March", 0)
+	model, _ = model.Update(click(x, y))
+	want := time.Date(2023, time.March, 31, 0, 0, 0, 0, time.UTC)
+	if model.ViewMode != ViewMonth || model.Time != want {
+		t.Errorf("TestMouseYearView failure - want: '%s' got: '%s'", want, model.Time)
+	}
+
+	model.SetViewMode(ViewYear)
+	x, y, _ = findText(model.View(), ">", 0)
+	model, _ = model.Update(click(x, y))
+	if model.ViewMode != ViewYear || model.Time.Year() != 2024 {
+		t.Errorf("TestMouseYearView failure - want: '%d' got: '%d'", 2024, model.Time.Year())
+	}
+
+	model, _ = model.Update(tea.MouseMsg{Type: tea.MouseWheelUp})
+	if model.Time.Year() != 2023 {
+		t.Errorf("TestMouseYearView failure - want: '%d' got: '%d'", 2023, model.Time.Year())
+	}
+}
@@ -0,0 +1,481 @@
+package datepicker
+
+import (
+	"archive/zip"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"runtime"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/sahilm/fuzzy"
+)
+
+// ZoneKeyMap is the key bindings for different actions within the timezone picker.
+// Printable keys are typed into the filter, so none of the bindings use them.
+type ZoneKeyMap struct {
+	Up       key.Binding
+	Down     key.Binding
+	LastPage key.Binding
+	NextPage key.Binding
+	Select   key.Binding
+	Cancel   key.Binding
+	Quit     key.Binding
+}
+
+// DefaultZoneKeyMap returns a ZoneKeyMap struct with default values
+func DefaultZoneKeyMap() ZoneKeyMap {
+	return ZoneKeyMap{
+		Up:       key.NewBinding(key.WithKeys("up", "ctrl+p"), key.WithHelp("↑", "up")),
+		Down:     key.NewBinding(key.WithKeys("down", "ctrl+n"), key.WithHelp("↓", "down")),
+		LastPage: key.NewBinding(key.WithKeys("pgup"), key.WithHelp("pgup", "last page")),
+		NextPage: key.NewBinding(key.WithKeys("pgdown"), key.WithHelp("pgdown", "next page")),
+		Select:   key.NewBinding(key.WithKeys("enter"), key.WithHelp("enter", "select")),
+		Cancel:   key.NewBinding(key.WithKeys("esc"), key.WithHelp("esc", "clear filter")),
+		Quit:     key.NewBinding(key.WithKeys("ctrl+c"), key.WithHelp("ctrl+c", "close")),
+	}
+}
+
+// ZoneStyles is a struct of lipgloss styles to apply to various elements of the timezone picker
+type ZoneStyles struct {
+	Zone   lipgloss.Style
+	Filter lipgloss.Style
+	Row    lipgloss.Style
+
+	Text         lipgloss.Style
+	FocusedText  lipgloss.Style
+	SelectedText lipgloss.Style
+	OffsetText   lipgloss.Style
+	NoMatchText  lipgloss.Style
+}
+
+// DefaultZoneStyles returns a default `ZoneStyles` struct
+func DefaultZoneStyles() ZoneStyles {
+	r := lipgloss.DefaultRenderer()
+	return ZoneStyles{
+		Zone:   r.NewStyle().Padding(1, 1),
+		Filter: r.NewStyle().PaddingBottom(1),
+		Row:    r.NewStyle(),
+
+		Text:         r.NewStyle().Foreground(lipgloss.Color("247")),
+		FocusedText:  r.NewStyle().Foreground(lipgloss.Color("212")).Bold(true),
+		SelectedText: r.NewStyle().Bold(true),
+		OffsetText:   r.NewStyle().Foreground(lipgloss.Color("241")),
+		NoMatchText:  r.NewStyle().Foreground(lipgloss.Color("240")).Italic(true),
+	}
+}
+
+// ZoneSelectedMsg is sent by `ZoneModel.Update` when the end user selects a time
+// zone. Passing it to `Model.Update`, `TimeModel.Update` or
+// `DateTimeModel.Update` shows their `Time` in the new location.
+type ZoneSelectedMsg struct {
+	Old *time.Location
+	New *time.Location
+}
+
+// ZoneModel is a struct that contains the state of the timezone picker
+// component and satisfies the `tea.Model` interface. It lists the zones of the
+// tz database along with their current UTC offsets, narrowed down by fuzzy
+// matching the text typed into its filter.
+type ZoneModel struct {
+	// Location is the selected time zone
+	Location *time.Location
+
+	// KeyMap encodes the keybindings recognized by the model
+	KeyMap ZoneKeyMap
+
+	// Styles represent the ZoneStyles struct used to render the timezone picker
+	Styles ZoneStyles
+
+	// Filter is the text input the end user types into to narrow down the zones
+	Filter textinput.Model
+
+	// Height is the number of zones shown at once. The zero value shows 10.
+	Height int
+
+	// Now returns the current time and is used to show the current UTC offset
+	// of each zone. A nil Now uses `time.Now`.
+	Now func() time.Time
+
+	// QuitOnClose makes `KeyMap.Quit` quit the bubbletea program instead of
+	// sending a `CloseMsg`
+	QuitOnClose bool
+
+	// zones are the zones that can be picked, and matches are the indexes of
+	// the zones matching the filter, best match first
+	zones   []zone
+	matches []int
+
+	// cursor is the position of the highlighted zone within matches, and top is
+	// the position of the first zone shown
+	cursor, top int
+
+	// offsetX and offsetY are where the timezone picker is drawn on the screen
+	offsetX, offsetY int
+}
+
+// zone is a time zone that can be picked
+type zone struct {
+	name string
+	loc  *time.Location
+
+	// search is the name the filter is matched against
+	search string
+}
+
+// NewZone returns the ZoneModel of the timezone picker listing the zones of
+// `ZoneNames`, with loc selected. The filter is focused.
+func NewZone(loc *time.Location) ZoneModel {
+	filter := textinput.New()
+	filter.Prompt = "> "
+	filter.Placeholder = "search time zones"
+	filter.Focus()
+
+	m := ZoneModel{
+		Location: loc,
+		KeyMap:   DefaultZoneKeyMap(),
+		Styles:   DefaultZoneStyles(),
+		Filter:   filter,
+		Now:      time.Now,
+	}
+	m.SetZones(ZoneNames()...)
+	return m
+}
+
+// SetZones replaces the zones that can be picked. Names that `time.LoadLocation`
+// does not know are left out.
+func (m *ZoneModel) SetZones(names ...string) {
+	m.zones = make([]zone, 0, len(names))
+	for _, name := range names {
+		loc, err := time.LoadLocation(name)
+		if err != nil {
+			continue
+		}
+		m.zones = append(m.zones, zone{name: name, loc: loc, search: strings.ReplaceAll(name, "_", " ")})
+	}
+	m.filterZones()
+}
+
+// Zones returns the names of the zones matching the filter, best match first
+func (m ZoneModel) Zones() []string {
+	names := make([]string, len(m.matches))
+	for i, z := range m.matches {
+		names[i] = m.zones[z].name
+	}
+	return names
+}
+
+// SetOffset tells the timezone picker where the top left corner of its view is
+// drawn on the screen, so that the coordinates of a `tea.MouseMsg` can be
+// mapped onto it
+func (m *ZoneModel) SetOffset(x, y int) {
+	m.offsetX = x
+	m.offsetY = y
+}
+
+// Init satisfies the `tea.Model` interface. This sends the cursor blink of the filter
+func (m ZoneModel) Init() tea.Cmd {
+	return textinput.Blink
+}
+
+// Update changes the state of the timezone picker. Update satisfies the `tea.Model` interface
+func (m ZoneModel) Update(msg tea.Msg) (ZoneModel, tea.Cmd) {
+	prev := m.Location
+	var cmd tea.Cmd
+
+	switch msg := msg.(type) {
+	case tea.MouseMsg:
+		m.updateMouse(msg)
+
+	case tea.KeyMsg:
+		switch {
+		case key.Matches(msg, m.KeyMap.Quit):
+			if m.QuitOnClose {
+				return m, tea.Quit
+			}
+			return m, func() tea.Msg { return CloseMsg{} }
+		case key.Matches(msg, m.KeyMap.Up):
+			m.moveCursor(-1)
+		case key.Matches(msg, m.KeyMap.Down):
+			m.moveCursor(1)
+		case key.Matches(msg, m.KeyMap.LastPage):
+			m.moveCursor(-m.height())
+		case key.Matches(msg, m.KeyMap.NextPage):
+			m.moveCursor(m.height())
+		case key.Matches(msg, m.KeyMap.Select):
+			m.SelectZone()
+		case key.Matches(msg, m.KeyMap.Cancel):
+			m.Filter.Reset()
+			m.filterZones()
+		default:
+			cmd = m.updateFilter(msg)
+		}
+
+	default:
+		m.Filter, cmd = m.Filter.Update(msg)
+	}
+
+	if m.Location == prev {
+		return m, cmd
+	}
+	selected := ZoneSelectedMsg{Old: prev, New: m.Location}
+	return m, batch(cmd, func() tea.Msg { return selected })
+}
+
+// updateFilter passes msg to the filter, matching the zones again when the
+// filter text changes
+func (m *ZoneModel) updateFilter(msg tea.Msg) tea.Cmd {
+	before := m.Filter.Value()
+	var cmd tea.Cmd
+	m.Filter, cmd = m.Filter.Update(msg)
+	if m.Filter.Value() != before {
+		m.filterZones()
+	}
+	return cmd
+}
+
+// SelectZone selects the highlighted zone
+func (m *ZoneModel) SelectZone() {
+	if len(m.matches) == 0 {
+		return
+	}
+	m.Location = m.zones[m.matches[m.cursor]].loc
+}
+
+// filterZones matches the zones against the filter and moves the cursor back
+// to the best match. An empty filter lists every zone in order.
+func (m *ZoneModel) filterZones() {
+	m.cursor, m.top = 0, 0
+	m.matches = nil
+
+	pattern := strings.ReplaceAll(strings.TrimSpace(m.Filter.Value()), "_", " ")
+	if pattern == "" {
+		for i := range m.zones {
+			m.matches = append(m.matches, i)
+		}
+		return
+	}
+	for _, match := range fuzzy.FindFrom(pattern, zoneSource(m.zones)) {
+		m.matches = append(m.matches, match.Index)
+	}
+}
+
+// zoneSource exposes the search names of zones to the fuzzy matcher
+type zoneSource []zone
+
+func (s zoneSource) String(i int) string { return s[i].search }
+func (s zoneSource) Len() int            { return len(s) }
+
+// moveCursor moves the highlighted zone by n rows, scrolling the list to keep
+// it in view
+func (m *ZoneModel) moveCursor(n int) {
+	if len(m.matches) == 0 {
+		return
+	}
+	m.cursor += n
+	if m.cursor < 0 {
+		m.cursor = 0
+	}
+	if m.cursor >= len(m.matches) {
+		m.cursor = len(m.matches) - 1
+	}
+
+	if m.cursor < m.top {
+		m.top = m.cursor
+	}
+	if h := m.height(); m.cursor >= m.top+h {
+		m.top = m.cursor - h + 1
+	}
+}
+
+// height returns the model's `Height`, falling back to 10 rows
+func (m ZoneModel) height() int {
+	if m.Height <= 0 {
+		return 10
+	}
+	return m.Height
+}
+
+// updateMouse selects the zone that is clicked, and scrolls the list with the
+// scroll wheel
+func (m *ZoneModel) updateMouse(msg tea.MouseMsg) {
+	layout := m.render()
+	x, y := msg.X-m.offsetX, msg.Y-m.offsetY
+	if !layout.contains(x, y) {
+		return
+	}
+
+	switch msg.Type {
+	case tea.MouseWheelUp:
+		m.moveCursor(-1)
+	case tea.MouseWheelDown:
+		m.moveCursor(1)
+	case tea.MouseLeft:
+		t, ok := layout.hit(x, y)
+		if !ok || t.kind != targetZone {
+			return
+		}
+		m.cursor = t.index
+		m.SelectZone()
+	}
+}
+
+// View renders the filter above the matching zones as a multiline string in
+// the bubbletea application. View satisfies the `tea.Model` interface.
+func (m ZoneModel) View() string {
+	return m.render().view
+}
+
+// render lays out the filter and zones along with the clickable regions used
+// to map mouse events onto them
+func (m ZoneModel) render() block {
+	rows := []block{plain(m.Styles.Filter.Render(m.Filter.View()))}
+
+	if len(m.matches) == 0 {
+		rows = append(rows, plain(m.Styles.NoMatchText.Render("no matching time zones")))
+		return styled(m.Styles.Zone, joinVertical(lipgloss.Left, rows...))
+	}
+
+	now := time.Now()
+	if m.Now != nil {
+		now = m.Now()
+	}
+
+	nameWidth := 0
+	end := m.top + m.height()
+	if end > len(m.matches) {
+		end = len(m.matches)
+	}
+	for _, z := range m.matches[m.top:end] {
+		if w := len(m.zones[z].name); w > nameWidth {
+			nameWidth = w
+		}
+	}
+
+	for i := m.top; i < end; i++ {
+		z := m.zones[m.matches[i]]
+		style := m.Styles.Text
+		switch {
+		case i == m.cursor:
+			style = m.Styles.FocusedText
+		case m.Location != nil && z.loc.String() == m.Location.String():
+			style = m.Styles.SelectedText
+		}
+		name := style.Render(fmt.Sprintf("%-*s", nameWidth, z.name))
+		offset := m.Styles.OffsetText.Render(formatOffset(now.In(z.loc)))
+		row := m.Styles.Row.Render(name + "  " + offset)
+		rows = append(rows, clickable(row, target{kind: targetZone, index: i}))
+	}
+	return styled(m.Styles.Zone, joinVertical(lipgloss.Left, rows...))
+}
+
+// formatOffset returns the UTC offset of t such as "UTC+05:30"
+func formatOffset(t time.Time) string {
+	_, offset := t.Zone()
+	sign := '+'
+	if offset < 0 {
+		sign = '-'
+		offset = -offset
+	}
+	return fmt.Sprintf("UTC%c%02d:%02d", sign, offset/3600, offset%3600/60)
+}
+
+// zoneRegions are the top level directories of the tz database holding the
+// canonical zone names. Other directories hold aliases kept for compatibility.
+var zoneRegions = map[string]bool{
+	"Africa": true, "America": true, "Antarctica": true, "Arctic": true, "Asia": true,
+	"Atlantic": true, "Australia": true, "Europe": true, "Indian": true, "Pacific": true,
+}
+
+// zoneDirs are the directories the tz database is installed in, the same ones
+// `time.LoadLocation` looks in
+var zoneDirs = []string{
+	"/usr/share/zoneinfo/",
+	"/usr/share/lib/zoneinfo/",
+	"/usr/lib/locale/TZ/",
+	"/etc/zoneinfo/",
+}
+
+// ZoneNames returns the sorted names of the zones in the tz database, such as
+// "America/New_York", along with "UTC". The database is read from the
+// directory or zip file named by $ZONEINFO, the system's installation or the
+// copy shipped with Go, and a short list of common zones is returned when none
+// of them can be read.
+func ZoneNames() []string {
+	sources := zoneDirs
+	if dir := os.Getenv("ZONEINFO"); dir != "" {
+		sources = append([]string{dir}, sources...)
+	}
+	sources = append(sources, filepath.Join(runtime.GOROOT(), "lib", "time", "zoneinfo.zip"))
+
+	for _, src := range sources {
+		if names := readZoneNames(src); len(names) > 0 {
+			return append([]string{"UTC"}, names...)
+		}
+	}
+	return append([]string(nil), commonZones...)
+}
+
+// readZoneNames returns the sorted names of the canonical zones in the tz
+// database directory or zip file src
+func readZoneNames(src string) []string {
+	var names []string
+	add := func(name string) {
+		name = filepath.ToSlash(name)
+		region, _, ok := strings.Cut(name, "/")
+		if ok && zoneRegions[region] {
+			names = append(names, name)
+		}
+	}
+
+	if strings.HasSuffix(src, ".zip") {
+		r, err := zip.OpenReader(src)
+		if err != nil {
+			return nil
+		}
+		defer r.Close()
+		for _, f := range r.File {
+			if !f.FileInfo().IsDir() {
+				add(f.Name)
+			}
+		}
+	} else {
+		root := os.DirFS(src)
+		_ = fs.WalkDir(root, ".", func(path string, d fs.DirEntry, err error) error {
+			if err == nil && !d.IsDir() {
+				add(path)
+			}
+			return nil
+		})
+	}
+
+	sort.Strings(names)
+	return names
+}
+
+// commonZones is the list of zones returned by `ZoneNames` when the tz database
+// cannot be read, such as on Windows programs importing `time/tzdata`
+var commonZones = []string{
+	"UTC",
+	"Africa/Cairo", "Africa/Johannesburg", "Africa/Lagos", "Africa/Nairobi",
+	"America/Anchorage", "America/Bogota", "America/Chicago", "America/Denver",
+	"America/Halifax", "America/Los_Angeles", "America/Mexico_City", "America/New_York",
+	"America/Phoenix", "America/Santiago", "America/Sao_Paulo", "America/St_Johns",
+	"America/Toronto", "America/Vancouver",
+	"Asia/Bangkok", "Asia/Dhaka", "Asia/Dubai", "Asia/Hong_Kong", "Asia/Jakarta",
+	"Asia/Jerusalem", "Asia/Karachi", "Asia/Kathmandu", "Asia/Kolkata", "Asia/Manila",
+	"Asia/Seoul", "Asia/Shanghai", "Asia/Singapore", "Asia/Tehran", "Asia/Tokyo",
+	"Atlantic/Azores", "Atlantic/Reykjavik",
+	"Australia/Adelaide", "Australia/Brisbane", "Australia/Perth", "Australia/Sydney",
+	"Europe/Amsterdam", "Europe/Athens", "Europe/Berlin", "Europe/Istanbul", "Europe/Lisbon",
+	"Europe/London", "Europe/Madrid", "Europe/Moscow", "Europe/Paris", "Europe/Rome",
+	"Europe/Stockholm", "Europe/Warsaw",
+	"Pacific/Auckland", "Pacific/Honolulu",
+}
@@ -0,0 +1,180 @@
+package datepicker
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// newTestZone returns a timezone picker listing the zones of commonZones at a
+// fixed time
+func newTestZone(t *testing.T) ZoneModel {
+	t.Helper()
+	model := NewZone(time.UTC)
+	model.SetZones(commonZones...)
+	if len(model.Zones()) != len(commonZones) {
+		t.Skipf("tz database unavailable: loaded %d of %d zones", len(model.Zones()), len(commonZones))
+	}
+	model.Now = clock(time.Date(2023, time.July, 1, 12, 0, 0, 0, time.UTC))
+	return model
+}
+
+func TestZoneFilter(t *testing.T) {
+	tests := []struct {
+		filter string
+		want   string
+	}{
+		{filter: "new york", want: "America/New_York"},
+		{filter: "new_york", want: "America/New_York"},
+		{filter: "tokyo", want: "Asia/Tokyo"},
+		{filter: "eurber", want: "Europe/Berlin"},
+		{filter: "kolk", want: "Asia/Kolkata"},
+	}
+	for _, test := range tests {
+		model := newTestZone(t)
+		for _, msg := range typeKeys(test.filter) {
+			model, _ = model.Update(msg)
+		}
+		zones := model.Zones()
+		if len(zones) == 0 || zones[0] != test.want {
+			t.Errorf("TestZoneFilter failure - %q - want first: '%s' got: '%v'", test.filter, test.want, zones)
+		}
+	}
+
+	model := newTestZone(t)
+	for _, msg := range typeKeys("xyzzy") {
+		model, _ = model.Update(msg)
+	}
+	if got := model.Zones(); len(got) != 0 {
+		t.Errorf("TestZoneFilter failure - want no zones got: '%v'", got)
+	}
+	if view := model.View(); !strings.Contains(view, "no matching time zones") {
+		t.Errorf("TestZoneFilter failure - want a no match notice got: '%s'", view)
+	}
+
+	model, _ = model.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	if got := len(model.Zones()); got != len(commonZones) {
+		t.Errorf("TestZoneFilter failure - want: %d zones after clearing got: %d", len(commonZones), got)
+	}
+}
+
+func TestZoneSelect(t *testing.T) {
+	model := newTestZone(t)
+	for _, msg := range typeKeys("europe") {
+		model, _ = model.Update(msg)
+	}
+
+	var cmd tea.Cmd
+	model, _ = model.Update(tea.KeyMsg{Type: tea.KeyDown})
+	model, cmd = model.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	want := model.Zones()[1]
+	if model.Location.String() != want {
+		t.Fatalf("TestZoneSelect failure - want: '%s' got: '%s'", want, model.Location)
+	}
+
+	msgs := collectMsgs(cmd)
+	selected, ok := msgs[len(msgs)-1].(ZoneSelectedMsg)
+	if !ok || selected.Old != time.UTC || selected.New != model.Location {
+		t.Fatalf("TestZoneSelect failure - want: '%v' got: '%v'", ZoneSelectedMsg{Old: time.UTC, New: model.Location}, msgs)
+	}
+
+	// the datepicker keeps the instant and shows it in the new location
+	dp := New(time.Date(2023, time.October, 31, 23, 30, 0, 0, time.UTC))
+	dp, _ = dp.Update(selected)
+	if dp.Time.Location() != selected.New || !dp.Time.Equal(time.Date(2023, time.October, 31, 23, 30, 0, 0, time.UTC)) {
+		t.Errorf("TestZoneSelect failure - want: '%s' in %s got: '%s'", "2023-10-31 23:30 UTC", selected.New, dp.Time)
+	}
+
+	// selecting the highlighted zone again sends nothing
+	if _, cmd = model.Update(tea.KeyMsg{Type: tea.KeyEnter}); cmd != nil {
+		t.Errorf("TestZoneSelect failure - expected no cmd when the zone is unchanged")
+	}
+}
+
+func TestZoneDateTime(t *testing.T) {
+	tokyo := loadLocation(t, "Asia/Tokyo")
+
+	model := NewDateTime(time.Date(2023, time.October, 31, 20, 0, 0, 0, time.UTC))
+	model, _ = model.Update(ZoneSelectedMsg{Old: time.UTC, New: tokyo})
+	if want := time.Date(2023, time.November, 1, 5, 0, 0, 0, tokyo); model.Time() != want {
+		t.Errorf("TestZoneDateTime failure - want: '%s' got: '%s'", want, model.Time())
+	}
+	if got, want := model.DatePicker.Date(), (Date{2023, time.November, 1}); got != want {
+		t.Errorf("TestZoneDateTime failure - want: '%s' got: '%s'", want, got)
+	}
+}
+
+func TestZoneView(t *testing.T) {
+	model := newTestZone(t)
+	for _, msg := range typeKeys("kolkata") {
+		model, _ = model.Update(msg)
+	}
+	if view := model.View(); !strings.Contains(view, "Asia/Kolkata  UTC+05:30") {
+		t.Errorf("TestZoneView failure - want: '%s' got: '%s'", "Asia/Kolkata  UTC+05:30", view)
+	}
+
+	tests := []struct {
+		zone string
+		want string
+	}{
+		{zone: "America/New_York", want: "UTC-04:00"},
+		{zone: "America/St_Johns", want: "UTC-02:30"},
+		{zone: "Asia/Kathmandu", want: "UTC+05:45"},
+		{zone: "UTC", want: "UTC+00:00"},
+	}
+	now := time.Date(2023, time.July, 1, 12, 0, 0, 0, time.UTC)
+	for _, test := range tests {
+		if got := formatOffset(now.In(loadLocation(t, test.zone))); got != test.want {
+			t.Errorf("TestZoneView failure - %s - want: '%s' got: '%s'", test.zone, test.want, got)
+		}
+	}
+}
+
+func TestZoneScroll(t *testing.T) {
+	model := newTestZone(t)
+	model.Height = 3
+
+	for i := 0; i < 4; i++ {
+		model, _ = model.Update(tea.KeyMsg{Type: tea.KeyDown})
+	}
+	if model.cursor != 4 || model.top != 2 {
+		t.Errorf("TestZoneScroll failure - want cursor 4 top 2 got: cursor %d top %d", model.cursor, model.top)
+	}
+
+	model, _ = model.Update(tea.KeyMsg{Type: tea.KeyPgUp})
+	if model.cursor != 1 || model.top != 1 {
+		t.Errorf("TestZoneScroll failure - want cursor 1 top 1 got: cursor %d top %d", model.cursor, model.top)
+	}
+}
+
+func TestZoneMouse(t *testing.T) {
+	model := newTestZone(t)
+	model.SetOffset(4, 2)
+
+	x, y, ok := findText(model.View(), "America/Bogota", 0)
+	if !ok {
+		t.Fatalf("TestZoneMouse failure - could not find America/Bogota")
+	}
+	model, _ = model.Update(click(x+4, y+2))
+	if got := model.Location.String(); got != "America/Bogota" {
+		t.Errorf("TestZoneMouse failure - want: '%s' got: '%s'", "America/Bogota", got)
+	}
+}
+
+func TestZoneNames(t *testing.T) {
+	names := ZoneNames()
+	found := map[string]bool{}
+	for _, name := range names {
+		found[name] = true
+		if strings.HasPrefix(name, "posix/") || strings.HasPrefix(name, "right/") {
+			t.Errorf("TestZoneNames failure - unexpected zone '%s'", name)
+		}
+	}
+	for _, want := range []string{"UTC", "America/New_York", "Europe/London", "Asia/Tokyo"} {
+		if !found[want] {
+			t.Errorf("TestZoneNames failure - missing zone '%s'", want)
+		}
+	}
+}